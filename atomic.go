@@ -0,0 +1,41 @@
+package tribool
+
+import "sync/atomic"
+
+/*
+Atomic is a Tribool that can be read and written from many goroutines without
+a lock, in the style of the typed values in sync/atomic. The zero value is No,
+like a plain Tribool, and an Atomic must not be copied after first use.
+*/
+type Atomic struct {
+	v atomic.Int32
+}
+
+/*
+Load atomically returns the value.
+*/
+func (x *Atomic) Load() Tribool {
+	return Tribool(x.v.Load())
+}
+
+/*
+Store atomically sets the value to a.
+*/
+func (x *Atomic) Store(a Tribool) {
+	x.v.Store(int32(a))
+}
+
+/*
+Swap atomically sets the value to a and returns the previous value.
+*/
+func (x *Atomic) Swap(a Tribool) (old Tribool) {
+	return Tribool(x.v.Swap(int32(a)))
+}
+
+/*
+CompareAndSwap atomically sets the value to new if it is old, and reports
+whether it did.
+*/
+func (x *Atomic) CompareAndSwap(old, new Tribool) (swapped bool) {
+	return x.v.CompareAndSwap(int32(old), int32(new))
+}
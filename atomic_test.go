@@ -0,0 +1,59 @@
+package tribool
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestAtomic(t *testing.T) {
+	var x Atomic
+	if actual := x.Load(); actual != No {
+		t.Errorf("zero Atomic Load() => %s instead of the expected no", actual)
+	}
+
+	x.Store(Maybe)
+	if actual := x.Load(); actual != Maybe {
+		t.Errorf("Load() after Store(maybe) => %s", actual)
+	}
+	if old := x.Swap(Yes); old != Maybe {
+		t.Errorf("Swap(yes) => %s instead of the expected maybe", old)
+	}
+	if x.CompareAndSwap(No, Maybe) {
+		t.Errorf("CompareAndSwap(no, maybe) swapped while the value was yes")
+	}
+	if !x.CompareAndSwap(Yes, No) {
+		t.Errorf("CompareAndSwap(yes, no) did not swap")
+	}
+	if actual := x.Load(); actual != No {
+		t.Errorf("Load() after CompareAndSwap(yes, no) => %s", actual)
+	}
+}
+
+func TestAtomic_concurrent(t *testing.T) {
+	var x Atomic
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				// rotate No -> Maybe -> Yes -> No with a CAS loop
+				for {
+					old := x.Load()
+					if x.CompareAndSwap(old, values[(int(old)+1)%3]) {
+						break
+					}
+				}
+				if a := x.Load(); !a.IsValid() {
+					t.Errorf("Load() => invalid value %d", int(a))
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	// 8000 rotations of a 3-cycle starting at No
+	if actual, expected := x.Load(), values[8000%3]; actual != expected {
+		t.Errorf("Load() after concurrent rotations => %s instead of the expected %s", actual, expected)
+	}
+}
@@ -0,0 +1,77 @@
+package tribool
+
+import "fmt"
+
+/*
+Op selects the operator that Combine folds its inputs with. Only associative
+operators are offered, so the grouping of the inputs does not matter.
+*/
+type Op int
+
+const (
+	OpAnd   Op = iota // a.And(b), which is Yes for no inputs
+	OpOr              // a.Or(b), which is No for no inputs
+	OpXor             // a.Xor(b), which is No for no inputs
+	OpEquiv           // a.Equiv(b), which is Yes for no inputs
+)
+
+/*
+Combine converts each input to a Tribool and folds them with op. The inputs may
+be of mixed types:
+
+	Tribool | itself
+	   bool | FromBool
+	  *bool | FromPointer
+	 string | FromString
+
+An error is returned for any other type, or an unknown op.
+
+	flag, err := tribool.Combine(tribool.OpAnd, cfg.Enabled, os.Getenv("FEATURE"), tribool.Maybe)
+*/
+func Combine(op Op, inputs ...any) (Tribool, error) {
+	var f func(a, b Tribool) Tribool
+	var acc Tribool
+	switch op {
+	case OpAnd:
+		f, acc = Tribool.And, yes
+	case OpOr:
+		f, acc = Tribool.Or, no
+	case OpXor:
+		f, acc = Tribool.Xor, no
+	case OpEquiv:
+		f, acc = Tribool.Equiv, yes
+	default:
+		return maybe, fmt.Errorf("tribool: unknown Op %d", int(op))
+	}
+
+	for i, input := range inputs {
+		a, ok := fromAnyString(input)
+		if !ok {
+			return maybe, fmt.Errorf("tribool: cannot combine input %d of type %T", i, input)
+		}
+		acc = f(acc, a)
+	}
+	return acc, nil
+}
+
+// fromAny converts a Tribool, bool, or *bool, and reports whether v was one of
+// those types.
+func fromAny(v any) (Tribool, bool) {
+	switch v := v.(type) {
+	case Tribool:
+		return v, true
+	case bool:
+		return FromBool(v), true
+	case *bool:
+		return FromPointer(v), true
+	}
+	return maybe, false
+}
+
+// fromAnyString is fromAny, but also converts a string with FromString.
+func fromAnyString(v any) (Tribool, bool) {
+	if s, ok := v.(string); ok {
+		return FromString(s), true
+	}
+	return fromAny(v)
+}
@@ -0,0 +1,53 @@
+package tribool
+
+import "testing"
+
+func TestCombine(t *testing.T) {
+	tr, fa := true, false
+	table := []struct {
+		op       Op
+		inputs   []any
+		expected Tribool
+	}{
+		{OpAnd, nil, Yes},
+		{OpOr, nil, No},
+		{OpXor, nil, No},
+		{OpEquiv, nil, Yes},
+		{OpAnd, []any{Yes, true, &tr, "on"}, Yes},
+		{OpAnd, []any{Yes, true, &tr, "off"}, No},
+		{OpAnd, []any{Yes, true, (*bool)(nil)}, Maybe},
+		{OpOr, []any{No, false, &fa, "yes"}, Yes},
+		{OpOr, []any{No, false, &fa, ""}, Maybe},
+		{OpOr, []any{Maybe, &tr}, Yes},
+		{OpXor, []any{true, "yes", &tr}, Yes},
+		{OpXor, []any{true, No}, Yes},
+		{OpEquiv, []any{true, "false"}, No},
+		{OpEquiv, []any{Maybe, false}, Maybe},
+	}
+	for _, test := range table {
+		actual, err := Combine(test.op, test.inputs...)
+		if err != nil {
+			t.Errorf("Combine(%d, %v) returned error: %v", test.op, test.inputs, err)
+		} else if actual != test.expected {
+			t.Errorf("Combine(%d, %v) => %s instead of the expected %s", test.op, test.inputs, actual, test.expected)
+		}
+	}
+}
+
+func TestCombine_errors(t *testing.T) {
+	table := []struct {
+		op     Op
+		inputs []any
+	}{
+		{OpAnd, []any{Yes, 1}},
+		{OpOr, []any{[]byte("yes")}},
+		{OpXor, []any{nil}},
+		{OpAnd, []any{new(Tribool)}},
+		{Op(99), []any{Yes}},
+	}
+	for _, test := range table {
+		if actual, err := Combine(test.op, test.inputs...); err == nil {
+			t.Errorf("Combine(%d, %v) => %s instead of an error", test.op, test.inputs, actual)
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package tribool
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+/*
+FromComparison converts the sign of a comparison result, such as from
+strings.Compare or bytes.Compare, to a Tribool.
+
+	      cmp | result
+	----------+-------
+	 negative | No
+	     zero | Maybe
+	 positive | Yes
+
+Mapping equality to Maybe is a modeling choice: it reads as "neither less nor
+greater".
+*/
+func FromComparison(cmp int) Tribool {
+	switch {
+	case cmp < 0:
+		return no
+	case cmp > 0:
+		return yes
+	}
+	return maybe
+}
+
+/*
+FromFloat converts a reading to a Tribool using a deadband between low and high.
+
+	             x | result
+	---------------+-------
+	      x < low  | No
+	low ≤ x ≤ high | Maybe
+	     high < x  | Yes
+	           NaN | Maybe
+
+If low > high the band is inverted and FromFloat returns Maybe for every x.
+*/
+func FromFloat(x, low, high float64) Tribool {
+	switch {
+	case low > high:
+		return maybe
+	case x < low:
+		return no
+	case x > high:
+		return yes
+	}
+	return maybe
+}
+
+/*
+FromContext converts the result of a cancelable computation to a Tribool. If ctx
+is done, the result cannot be trusted and FromContext returns Maybe; otherwise
+it returns FromBool(result).
+
+This models the case where an answer is unknown because the work was canceled or
+timed out, such as an http request whose response never arrived.
+*/
+func FromContext(ctx context.Context, result bool) Tribool {
+	if ctx.Err() != nil {
+		return maybe
+	}
+	return FromBool(result)
+}
+
+/*
+FromError converts the outcome of an operation to a Tribool:
+
+	                  err | result
+	----------------------+-----------------------
+	                  nil | FromBool(successValue)
+	        indeterminate | Maybe
+	      any other error | No
+
+An error is indeterminate if IsIndeterminateError reports so; then the operation
+may or may not have taken effect, like an http request whose connection dropped
+before the response arrived.
+*/
+func FromError(err error, successValue bool) Tribool {
+	return FromErrorFunc(err, successValue, IsIndeterminateError)
+}
+
+/*
+FromErrorFunc is like FromError, but uses isIndeterminate to decide whether an
+error is indeterminate.
+*/
+func FromErrorFunc(err error, successValue bool, isIndeterminate func(error) bool) Tribool {
+	switch {
+	case err == nil:
+		return FromBool(successValue)
+	case isIndeterminate(err):
+		return maybe
+	}
+	return no
+}
+
+/*
+IsIndeterminateError reports whether err, or any error it wraps, leaves the
+outcome of an operation unknown. These are context.DeadlineExceeded,
+io.ErrUnexpectedEOF, and any error with a Timeout method that returns true,
+such as a net.Error.
+*/
+func IsIndeterminateError(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+	var timeout interface{ Timeout() bool }
+	return errors.As(err, &timeout) && timeout.Timeout()
+}
+
+/*
+FromPointer converts an optional bool to a Tribool. It is Maybe if p is nil,
+and FromBool(*p) otherwise.
+*/
+func FromPointer(p *bool) Tribool {
+	if p == nil {
+		return maybe
+	}
+	return FromBool(*p)
+}
+
+/*
+AsRef converts the Tribool to a value for dynamic expression engines, such as
+a CEL variable binding, that do not know about Tribools: true for Yes, false for
+No, and nil for Maybe.
+*/
+func (a Tribool) AsRef() any {
+	switch a {
+	case yes:
+		return true
+	case no:
+		return false
+	}
+	return nil
+}
+
+/*
+FromRef is the inverse of AsRef: true is Yes, false is No, and nil is Maybe. A
+Tribool or *bool is converted as for the variables of Eval. Any other value,
+including a string, is Maybe.
+*/
+func FromRef(v any) Tribool {
+	a, _ := fromAny(v)
+	return a
+}
+
+/*
+FromKnownValue converts a pair of bools, whether the value is known and what it
+is, to a Tribool. It is Maybe if known is false, and FromBool(value) otherwise.
+*/
+func FromKnownValue(known, value bool) Tribool {
+	if !known {
+		return maybe
+	}
+	return FromBool(value)
+}
+
+/*
+KnownValue splits a Tribool into whether it is known and, if so, its value. It
+is the inverse of FromKnownValue.
+
+	a | known  value
+	--+-------------
+	N | true   false
+	? | false  false
+	Y | true   true
+*/
+func (a Tribool) KnownValue() (known, value bool) {
+	return a != maybe, a == yes
+}
@@ -0,0 +1,226 @@
+package tribool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"testing"
+)
+
+func TestFromComparison(t *testing.T) {
+	table := []struct {
+		cmp      int
+		expected Tribool
+	}{
+		{math.MinInt, No}, {-7, No}, {-1, No},
+		{0, Maybe},
+		{1, Yes}, {7, Yes}, {math.MaxInt, Yes},
+	}
+	for _, test := range table {
+		actual := FromComparison(test.cmp)
+		if actual != test.expected {
+			t.Errorf("FromComparison(%d) => %s instead of the expected %s", test.cmp, actual, test.expected)
+		}
+	}
+}
+
+func TestFromFloat(t *testing.T) {
+	table := []struct {
+		x, low, high float64
+		expected     Tribool
+	}{
+		{-1, 0, 1, No},
+		{0, 0, 1, Maybe},
+		{0.5, 0, 1, Maybe},
+		{1, 0, 1, Maybe},
+		{2, 0, 1, Yes},
+		{math.Inf(-1), 0, 1, No},
+		{math.Inf(1), 0, 1, Yes},
+		{math.NaN(), 0, 1, Maybe},
+		{0.5, 0.5, 0.5, Maybe},
+		{0.4, 0.5, 0.5, No},
+		{0.6, 0.5, 0.5, Yes},
+
+		// inverted thresholds
+		{-1, 1, 0, Maybe},
+		{0.5, 1, 0, Maybe},
+		{2, 1, 0, Maybe},
+	}
+	for _, test := range table {
+		actual := FromFloat(test.x, test.low, test.high)
+		if actual != test.expected {
+			t.Errorf("FromFloat(%v, %v, %v) => %s instead of the expected %s", test.x, test.low, test.high, actual, test.expected)
+		}
+	}
+}
+
+func TestFromContext(t *testing.T) {
+	live := context.Background()
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	expired, cancel := context.WithTimeout(context.Background(), 0)
+	defer cancel()
+
+	table := []struct {
+		name     string
+		ctx      context.Context
+		result   bool
+		expected Tribool
+	}{
+		{"live", live, true, Yes},
+		{"live", live, false, No},
+		{"canceled", canceled, true, Maybe},
+		{"canceled", canceled, false, Maybe},
+		{"expired", expired, true, Maybe},
+	}
+	for _, test := range table {
+		actual := FromContext(test.ctx, test.result)
+		if actual != test.expected {
+			t.Errorf("FromContext(%s, %v) => %s instead of the expected %s", test.name, test.result, actual, test.expected)
+		}
+	}
+}
+
+type timeoutError struct {
+	timeout bool
+}
+
+func (e timeoutError) Error() string { return "i/o timeout" }
+func (e timeoutError) Timeout() bool { return e.timeout }
+
+func TestFromError(t *testing.T) {
+	table := []struct {
+		err          error
+		successValue bool
+		expected     Tribool
+	}{
+		{nil, true, Yes},
+		{nil, false, No},
+		{errors.New("refused"), true, No},
+		{io.EOF, true, No},
+		{context.Canceled, true, No},
+		{context.DeadlineExceeded, true, Maybe},
+		{io.ErrUnexpectedEOF, true, Maybe},
+		{os.ErrDeadlineExceeded, true, Maybe},
+		{timeoutError{true}, true, Maybe},
+		{timeoutError{false}, true, No},
+		{fmt.Errorf("posting: %w", context.DeadlineExceeded), true, Maybe},
+		{fmt.Errorf("posting: %w", fmt.Errorf("reading: %w", io.ErrUnexpectedEOF)), true, Maybe},
+		{fmt.Errorf("dialing: %w", timeoutError{true}), false, Maybe},
+	}
+	for _, test := range table {
+		actual := FromError(test.err, test.successValue)
+		if actual != test.expected {
+			t.Errorf("FromError(%v, %v) => %s instead of the expected %s", test.err, test.successValue, actual, test.expected)
+		}
+	}
+}
+
+func TestFromErrorFunc(t *testing.T) {
+	errRetry := errors.New("retry later")
+	isIndeterminate := func(err error) bool {
+		return errors.Is(err, errRetry)
+	}
+	table := []struct {
+		err      error
+		expected Tribool
+	}{
+		{nil, Yes},
+		{errRetry, Maybe},
+		{fmt.Errorf("saving: %w", errRetry), Maybe},
+		{context.DeadlineExceeded, No},
+	}
+	for _, test := range table {
+		actual := FromErrorFunc(test.err, true, isIndeterminate)
+		if actual != test.expected {
+			t.Errorf("FromErrorFunc(%v) => %s instead of the expected %s", test.err, actual, test.expected)
+		}
+	}
+}
+
+func TestFromKnownValue(t *testing.T) {
+	table := []struct {
+		known, value bool
+		expected     Tribool
+	}{
+		{false, false, Maybe},
+		{false, true, Maybe},
+		{true, false, No},
+		{true, true, Yes},
+	}
+	for _, test := range table {
+		actual := FromKnownValue(test.known, test.value)
+		if actual != test.expected {
+			t.Errorf("FromKnownValue(%v, %v) => %s instead of the expected %s", test.known, test.value, actual, test.expected)
+		}
+	}
+}
+
+func TestTribool_KnownValue(t *testing.T) {
+	table := []struct {
+		tri          Tribool
+		known, value bool
+	}{
+		{No, true, false},
+		{Maybe, false, false},
+		{Yes, true, true},
+	}
+	for _, test := range table {
+		known, value := test.tri.KnownValue()
+		if known != test.known || value != test.value {
+			t.Errorf("%s.KnownValue() => %v, %v instead of the expected %v, %v", test.tri, known, value, test.known, test.value)
+		}
+		if back := FromKnownValue(known, value); back != test.tri {
+			t.Errorf("FromKnownValue(%s.KnownValue()) => %s", test.tri, back)
+		}
+	}
+}
+
+func TestFromPointer(t *testing.T) {
+	tr, fa := true, false
+	table := []struct {
+		p        *bool
+		expected Tribool
+	}{
+		{&tr, Yes}, {&fa, No}, {nil, Maybe},
+	}
+	for _, test := range table {
+		if actual := FromPointer(test.p); actual != test.expected {
+			t.Errorf("FromPointer(%v) => %s instead of the expected %s", test.p, actual, test.expected)
+		}
+	}
+}
+
+func TestTribool_AsRef(t *testing.T) {
+	table := []struct {
+		a        Tribool
+		expected any
+	}{
+		{Yes, true}, {No, false}, {Maybe, nil},
+	}
+	for _, test := range table {
+		ref := test.a.AsRef()
+		if ref != test.expected {
+			t.Errorf("%s.AsRef() => %#v instead of the expected %#v", test.a, ref, test.expected)
+		}
+		if back := FromRef(ref); back != test.a {
+			t.Errorf("FromRef(%s.AsRef()) => %s", test.a, back)
+		}
+	}
+
+	tr := true
+	others := []struct {
+		v        any
+		expected Tribool
+	}{
+		{Yes, Yes}, {&tr, Yes}, {(*bool)(nil), Maybe}, {"true", Maybe}, {1, Maybe},
+	}
+	for _, test := range others {
+		if actual := FromRef(test.v); actual != test.expected {
+			t.Errorf("FromRef(%#v) => %s instead of the expected %s", test.v, actual, test.expected)
+		}
+	}
+}
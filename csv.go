@@ -0,0 +1,29 @@
+package tribool
+
+/*
+CSVField converts a Tribool to a CSV cell value. Maybe is written as an empty
+cell, the usual convention for an unknown value.
+
+	a | a.CSVField()
+	--+-------------
+	N | "no"
+	? | ""
+	Y | "yes"
+*/
+func (a Tribool) CSVField() string {
+	if a == maybe {
+		return ""
+	}
+	return a.String()
+}
+
+/*
+FromCSVField converts a CSV cell value to a Tribool. An empty cell is Maybe;
+anything else is parsed with FromString.
+*/
+func FromCSVField(s string) Tribool {
+	if s == "" {
+		return maybe
+	}
+	return FromString(s)
+}
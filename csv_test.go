@@ -0,0 +1,41 @@
+package tribool
+
+import "testing"
+
+func TestTribool_CSVField(t *testing.T) {
+	table := []struct {
+		tri      Tribool
+		expected string
+	}{
+		{Yes, "yes"},
+		{Maybe, ""},
+		{No, "no"},
+	}
+	for _, test := range table {
+		actual := test.tri.CSVField()
+		if actual != test.expected {
+			t.Errorf("%s.CSVField() => %q instead of the expected %q", test.tri, actual, test.expected)
+		}
+		if back := FromCSVField(actual); back != test.tri {
+			t.Errorf("FromCSVField(%q) => %s instead of the expected %s", actual, back, test.tri)
+		}
+	}
+}
+
+func TestFromCSVField(t *testing.T) {
+	table := []struct {
+		raw      string
+		expected Tribool
+	}{
+		{"", Maybe},
+		{"yes", Yes}, {"no", No},
+		{"TRUE", Yes}, {"0", No},
+		{"maybe", Maybe}, {"n/a", Maybe},
+	}
+	for _, test := range table {
+		actual := FromCSVField(test.raw)
+		if actual != test.expected {
+			t.Errorf("FromCSVField(%q) => %s instead of the expected %s", test.raw, actual, test.expected)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package tribool
+
+/*
+Change describes how a Tribool changed between two snapshots. See
+Tribool.Delta.
+*/
+type Change int
+
+const (
+	Unchanged           Change = iota // the state is the same
+	BecameTrue                        // No became Yes
+	BecameFalse                       // Yes became No
+	BecameUnknown                     // Yes or No became Maybe
+	ResolvedFromUnknown               // Maybe became Yes or No
+)
+
+var changeStrings = [...]string{"unchanged", "became true", "became false", "became unknown", "resolved from unknown"}
+
+/*
+String returns a lowercase description of the change, such as "became true".
+*/
+func (c Change) String() string {
+	return changeStrings[c]
+}
+
+/*
+Delta describes how the state changed from prev to a:
+
+	prev | a | a.Delta(prev)
+	-----+---+--------------------
+	   N | N | Unchanged
+	   N | ? | BecameUnknown
+	   N | Y | BecameTrue
+	   ? | N | ResolvedFromUnknown
+	   ? | ? | Unchanged
+	   ? | Y | ResolvedFromUnknown
+	   Y | N | BecameFalse
+	   Y | ? | BecameUnknown
+	   Y | Y | Unchanged
+
+A value resolved from Maybe is ResolvedFromUnknown whichever way it resolved;
+check a itself for the direction.
+*/
+func (a Tribool) Delta(prev Tribool) Change {
+	switch {
+	case a == prev:
+		return Unchanged
+	case a == maybe:
+		return BecameUnknown
+	case prev == maybe:
+		return ResolvedFromUnknown
+	case a == yes:
+		return BecameTrue
+	}
+	return BecameFalse
+}
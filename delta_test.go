@@ -0,0 +1,35 @@
+package tribool
+
+import "testing"
+
+func TestTribool_Delta(t *testing.T) {
+	N, x, Y := No, Maybe, Yes
+	table := []struct {
+		prev, a  Tribool
+		expected Change
+	}{
+		{N, N, Unchanged},
+		{N, x, BecameUnknown},
+		{N, Y, BecameTrue},
+		{x, N, ResolvedFromUnknown},
+		{x, x, Unchanged},
+		{x, Y, ResolvedFromUnknown},
+		{Y, N, BecameFalse},
+		{Y, x, BecameUnknown},
+		{Y, Y, Unchanged},
+	}
+	for _, test := range table {
+		if actual := test.a.Delta(test.prev); actual != test.expected {
+			t.Errorf("%s.Delta(%s) => %s instead of the expected %s", test.a, test.prev, actual, test.expected)
+		}
+	}
+}
+
+func TestChange_String(t *testing.T) {
+	if actual := BecameTrue.String(); actual != "became true" {
+		t.Errorf("BecameTrue.String() => %s instead of the expected became true", actual)
+	}
+	if actual := ResolvedFromUnknown.String(); actual != "resolved from unknown" {
+		t.Errorf("ResolvedFromUnknown.String() => %s instead of the expected resolved from unknown", actual)
+	}
+}
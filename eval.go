@@ -0,0 +1,217 @@
+package tribool
+
+import "fmt"
+
+/*
+Eval evaluates a logical expression over the named variables in vars. The
+values may be Tribools, bools, or *bools, which are converted with FromBool and
+FromPointer, so a map[string]Tribool, a map[string]bool, and a map[string]any
+mixing those types all work.
+
+Expressions are made of variable names, parentheses, and the operators below,
+listed from highest to lowest precedence:
+
+	not      a.Not()
+	and      a.And(b)
+	xor      a.Xor(b)
+	or       a.Or(b)
+	implies  a.Imply(b)
+
+The binary operators group left to right, except implies which groups right to
+left, so "a implies b implies c" is "a implies (b implies c)". Operators are
+lowercase; variable names are letters, digits and underscores, not starting
+with a digit.
+
+For example:
+
+	tribool.Eval("a and (b or not c)", map[string]tribool.Tribool{
+		"a": tribool.Yes, "b": tribool.Maybe, "c": tribool.No,
+	})
+
+A malformed expression returns a *SyntaxError. A variable missing from vars, or
+whose value is of another type, returns an error naming it.
+*/
+func Eval[V any](expr string, vars map[string]V) (Tribool, error) {
+	p := &evaluator{expr: expr, lookup: func(name string) (any, bool) {
+		v, ok := vars[name]
+		return v, ok
+	}}
+	if err := p.next(); err != nil {
+		return maybe, err
+	}
+	a, err := p.implies()
+	if err != nil {
+		return maybe, err
+	}
+	if p.tok != "" {
+		return maybe, p.errorf("unexpected %q", p.tok)
+	}
+	return a, nil
+}
+
+/*
+SyntaxError describes a malformed expression passed to Eval.
+*/
+type SyntaxError struct {
+	Offset int // the byte offset in the expression where the error was found
+	msg    string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("tribool: syntax error at offset %d: %s", e.Offset, e.msg)
+}
+
+// evaluator is a recursive descent parser that evaluates as it parses.
+type evaluator struct {
+	expr   string
+	pos    int    // offset of the next unread byte
+	tok    string // the current token, or "" at the end of expr
+	tokPos int    // offset of the current token
+	lookup func(name string) (any, bool)
+}
+
+func (p *evaluator) errorf(format string, args ...interface{}) error {
+	return &SyntaxError{Offset: p.tokPos, msg: fmt.Sprintf(format, args...)}
+}
+
+// next advances to the next token.
+func (p *evaluator) next() error {
+	for p.pos < len(p.expr) && isSpace(p.expr[p.pos]) {
+		p.pos++
+	}
+	p.tokPos = p.pos
+	if p.pos == len(p.expr) {
+		p.tok = ""
+		return nil
+	}
+
+	ch := p.expr[p.pos]
+	switch {
+	case ch == '(' || ch == ')':
+		p.pos++
+	case isIdentStart(ch):
+		for p.pos < len(p.expr) && isIdent(p.expr[p.pos]) {
+			p.pos++
+		}
+	default:
+		return &SyntaxError{Offset: p.pos, msg: fmt.Sprintf("unexpected character %q", ch)}
+	}
+	p.tok = p.expr[p.tokPos:p.pos]
+	return nil
+}
+
+// implies := or ("implies" implies)?
+func (p *evaluator) implies() (Tribool, error) {
+	a, err := p.or()
+	if err != nil || p.tok != "implies" {
+		return a, err
+	}
+	if err := p.next(); err != nil {
+		return maybe, err
+	}
+	b, err := p.implies()
+	if err != nil {
+		return maybe, err
+	}
+	return a.Imply(b), nil
+}
+
+// or := xor ("or" xor)*
+func (p *evaluator) or() (Tribool, error) {
+	return p.binary("or", p.xor, Tribool.Or)
+}
+
+// xor := and ("xor" and)*
+func (p *evaluator) xor() (Tribool, error) {
+	return p.binary("xor", p.and, Tribool.Xor)
+}
+
+// and := not ("and" not)*
+func (p *evaluator) and() (Tribool, error) {
+	return p.binary("and", p.not, Tribool.And)
+}
+
+// binary parses a left-associative sequence of operands separated by op.
+func (p *evaluator) binary(op string, operand func() (Tribool, error), f func(a, b Tribool) Tribool) (Tribool, error) {
+	a, err := operand()
+	for err == nil && p.tok == op {
+		if err = p.next(); err != nil {
+			break
+		}
+		var b Tribool
+		if b, err = operand(); err == nil {
+			a = f(a, b)
+		}
+	}
+	if err != nil {
+		return maybe, err
+	}
+	return a, nil
+}
+
+// not := "not" not | primary
+func (p *evaluator) not() (Tribool, error) {
+	if p.tok != "not" {
+		return p.primary()
+	}
+	if err := p.next(); err != nil {
+		return maybe, err
+	}
+	a, err := p.not()
+	if err != nil {
+		return maybe, err
+	}
+	return a.Not(), nil
+}
+
+// primary := name | "(" implies ")"
+func (p *evaluator) primary() (Tribool, error) {
+	switch tok := p.tok; {
+	case tok == "":
+		return maybe, p.errorf("unexpected end of expression")
+	case tok == "(":
+		if err := p.next(); err != nil {
+			return maybe, err
+		}
+		a, err := p.implies()
+		if err != nil {
+			return maybe, err
+		}
+		if p.tok != ")" {
+			return maybe, p.errorf("expected \")\"")
+		}
+		return a, p.next()
+	case tok == ")" || isOperator(tok):
+		return maybe, p.errorf("unexpected %q", tok)
+	default:
+		v, ok := p.lookup(tok)
+		if !ok {
+			return maybe, fmt.Errorf("tribool: undefined variable %q at offset %d", tok, p.tokPos)
+		}
+		a, ok := fromAny(v)
+		if !ok {
+			return maybe, fmt.Errorf("tribool: variable %q at offset %d is a %T, not a Tribool, bool, or *bool", tok, p.tokPos, v)
+		}
+		return a, p.next()
+	}
+}
+
+func isOperator(tok string) bool {
+	switch tok {
+	case "not", "and", "xor", "or", "implies":
+		return true
+	}
+	return false
+}
+
+func isSpace(ch byte) bool {
+	return ch == ' ' || ch == '\t' || ch == '\n' || ch == '\r'
+}
+
+func isIdentStart(ch byte) bool {
+	return ch == '_' || 'a' <= ch && ch <= 'z' || 'A' <= ch && ch <= 'Z'
+}
+
+func isIdent(ch byte) bool {
+	return isIdentStart(ch) || '0' <= ch && ch <= '9'
+}
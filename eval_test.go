@@ -0,0 +1,125 @@
+package tribool
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestEval(t *testing.T) {
+	N, x, Y := No, Maybe, Yes
+	vars := map[string]Tribool{"n": N, "m": x, "y": Y, "y_2": Y}
+	table := []struct {
+		expr     string
+		expected Tribool
+	}{
+		{"y", Y},
+		{"not y", N},
+		{"not not y", Y},
+		{"not m", x},
+		{"y and n", N},
+		{"y or n", Y},
+		{"y xor y", N},
+		{"n implies m", Y},
+		{"y_2 and m", x},
+
+		// precedence: not > and > xor > or > implies
+		{"not n and n", N},
+		{"y or y and n", Y},
+		{"y xor y or y", Y},
+		{"y xor n and n", Y},
+		{"y or n implies n", N},
+		{"n implies n implies n", Y},
+
+		// parentheses
+		{"not (n and n)", Y},
+		{"(y or y) and n", N},
+		{"y xor (y or y)", N},
+		{"(n implies n) implies n", N},
+		{" ( ( y ) ) ", Y},
+		{"y and (m or not n)", Y},
+	}
+	for _, test := range table {
+		actual, err := Eval(test.expr, vars)
+		if err != nil {
+			t.Errorf("Eval(%q) returned error: %v", test.expr, err)
+		} else if actual != test.expected {
+			t.Errorf("Eval(%q) => %s instead of the expected %s", test.expr, actual, test.expected)
+		}
+	}
+}
+
+func TestEval_errors(t *testing.T) {
+	vars := map[string]Tribool{"a": Yes, "b": No}
+	table := []struct {
+		expr   string
+		offset int
+	}{
+		{"", 0},
+		{"a and", 5},
+		{"a b", 2},
+		{"(a or b", 7},
+		{"a or b)", 6},
+		{"and a", 0},
+		{"not", 3},
+		{"a & b", 2},
+		{"()", 1},
+	}
+	for _, test := range table {
+		_, err := Eval(test.expr, vars)
+		var syntaxErr *SyntaxError
+		if !errors.As(err, &syntaxErr) {
+			t.Errorf("Eval(%q) => %v instead of a syntax error", test.expr, err)
+		} else if syntaxErr.Offset != test.offset {
+			t.Errorf("Eval(%q) => error at offset %d instead of the expected %d", test.expr, syntaxErr.Offset, test.offset)
+		}
+	}
+
+	_, err := Eval("a and c", vars)
+	if err == nil {
+		t.Errorf("Eval with an undefined variable should return an error")
+	} else if _, ok := err.(*SyntaxError); ok {
+		t.Errorf("Eval with an undefined variable => %v instead of an undefined variable error", err)
+	}
+}
+
+func TestEval_mixedVars(t *testing.T) {
+	tr, fa := true, false
+	vars := map[string]any{
+		"on": true, "off": false,
+		"pon": &tr, "poff": &fa, "unset": (*bool)(nil),
+		"yes": Yes, "maybe": Maybe,
+	}
+	table := []struct {
+		expr     string
+		expected Tribool
+	}{
+		{"on and yes", Yes},
+		{"off or maybe", Maybe},
+		{"pon and not poff", Yes},
+		{"unset or off", Maybe},
+		{"unset or pon", Yes},
+		{"on implies maybe", Maybe},
+		{"yes xor on", No},
+	}
+	for _, test := range table {
+		actual, err := Eval(test.expr, vars)
+		if err != nil {
+			t.Errorf("Eval(%q) returned error: %v", test.expr, err)
+		} else if actual != test.expected {
+			t.Errorf("Eval(%q) => %s instead of the expected %s", test.expr, actual, test.expected)
+		}
+	}
+
+	if actual, err := Eval("a and not b", map[string]bool{"a": true, "b": false}); err != nil || actual != Yes {
+		t.Errorf("Eval over a map[string]bool => %s, %v instead of the expected yes", actual, err)
+	}
+
+	for _, bad := range []any{"yes", 1, nil, new(Tribool)} {
+		_, err := Eval("on and a", map[string]any{"on": true, "a": bad})
+		if err == nil {
+			t.Errorf("Eval with a variable of type %T should return an error", bad)
+		} else if _, ok := err.(*SyntaxError); ok {
+			t.Errorf("Eval with a variable of type %T => %v instead of a type error", bad, err)
+		}
+	}
+}
@@ -1,6 +1,10 @@
 package tribool
 
-import "fmt"
+import (
+	"fmt"
+	"os"
+	"text/template"
+)
 
 func ExampleTribool() {
 	var u User // initialized elsewhere
@@ -77,3 +81,29 @@ func ExampleTribool_parsing() {
 	// yes no yes no yes no yes no yes no maybe maybe
 	// true false true
 }
+
+func ExampleFuncMap() {
+	tmpl := template.Must(template.New("flag").Funcs(FuncMap()).Parse(
+		`{{if isMaybe .}}unknown{{else if isTrue .}}on ({{triLabel .}}){{else}}off ({{triLabel .}}){{end}}` + "\n",
+	))
+
+	for _, flag := range []Tribool{Yes, Maybe, No} {
+		tmpl.Execute(os.Stdout, flag)
+	}
+
+	// Output:
+	// on (yes)
+	// unknown
+	// off (no)
+}
+
+func ExampleMaybeAsFalse() {
+	flags := []Tribool{Yes, Maybe, No}
+
+	fmt.Println(MapBools(flags, MaybeAsFalse))
+	fmt.Println(MapBools(flags, MaybeAsTrue))
+
+	// Output:
+	// [true false false]
+	// [true true false]
+}
@@ -0,0 +1,108 @@
+package tribool
+
+/*
+Start begins a fluent expression with a, for composing conditions left to right
+without nesting:
+
+	allowed := tribool.Start(isOwner).
+		Or(isAdmin).
+		AndBool(enabled).
+		WithMaybeAsFalse()
+
+Each step applies to the result so far, so Start(a).And(b).Or(c).Not() is
+a.And(b).Or(c).Not().
+*/
+func Start(a Tribool) Expr {
+	return Expr{a}
+}
+
+/*
+Expr holds the running result of an expression. See Start.
+*/
+type Expr struct {
+	a Tribool
+}
+
+/*
+And is equivalent to Start(e.Result().And(b))
+*/
+func (e Expr) And(b Tribool) Expr {
+	return Expr{e.a.And(b)}
+}
+
+/*
+AndBool is equivalent to e.And(FromBool(b))
+*/
+func (e Expr) AndBool(b bool) Expr {
+	return e.And(FromBool(b))
+}
+
+/*
+Or is equivalent to Start(e.Result().Or(b))
+*/
+func (e Expr) Or(b Tribool) Expr {
+	return Expr{e.a.Or(b)}
+}
+
+/*
+OrBool is equivalent to e.Or(FromBool(b))
+*/
+func (e Expr) OrBool(b bool) Expr {
+	return e.Or(FromBool(b))
+}
+
+/*
+Xor is equivalent to Start(e.Result().Xor(b))
+*/
+func (e Expr) Xor(b Tribool) Expr {
+	return Expr{e.a.Xor(b)}
+}
+
+/*
+XorBool is equivalent to e.Xor(FromBool(b))
+*/
+func (e Expr) XorBool(b bool) Expr {
+	return e.Xor(FromBool(b))
+}
+
+/*
+Imply is equivalent to Start(e.Result().Imply(b))
+*/
+func (e Expr) Imply(b Tribool) Expr {
+	return Expr{e.a.Imply(b)}
+}
+
+/*
+ImplyBool is equivalent to e.Imply(FromBool(b))
+*/
+func (e Expr) ImplyBool(b bool) Expr {
+	return e.Imply(FromBool(b))
+}
+
+/*
+Not negates the result so far.
+*/
+func (e Expr) Not() Expr {
+	return Expr{e.a.Not()}
+}
+
+/*
+Result returns the result of the expression.
+*/
+func (e Expr) Result() Tribool {
+	return e.a
+}
+
+/*
+WithMaybeAsTrue is equivalent to e.Result().WithMaybeAsTrue()
+*/
+func (e Expr) WithMaybeAsTrue() bool {
+	return e.a.WithMaybeAsTrue()
+}
+
+/*
+WithMaybeAsFalse is equivalent to e.Result().WithMaybeAsFalse()
+*/
+func (e Expr) WithMaybeAsFalse() bool {
+	return e.a.WithMaybeAsFalse()
+}
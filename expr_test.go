@@ -0,0 +1,38 @@
+package tribool
+
+import "testing"
+
+func TestExpr(t *testing.T) {
+	for _, a := range values {
+		for _, b := range values {
+			for _, c := range values {
+				table := []struct {
+					name             string
+					actual, expected Tribool
+				}{
+					{"a and b or c not", Start(a).And(b).Or(c).Not().Result(), a.And(b).Or(c).Not()},
+					{"a xor b imply c", Start(a).Xor(b).Imply(c).Result(), a.Xor(b).Imply(c)},
+					{"not a or b and c", Start(a).Not().Or(b).And(c).Result(), a.Not().Or(b).And(c)},
+				}
+				for _, test := range table {
+					if test.actual != test.expected {
+						t.Errorf("%s with a=%s, b=%s, c=%s => %s instead of the expected %s", test.name, a, b, c, test.actual, test.expected)
+					}
+				}
+			}
+
+			for _, bb := range []bool{false, true} {
+				e := Start(a).OrBool(bb).AndBool(bb).XorBool(bb).ImplyBool(bb)
+				expected := a.OrBool(bb).AndBool(bb).XorBool(bb).ImplyBool(bb)
+				if e.Result() != expected {
+					t.Errorf("Bool variants with a=%s, b=%v => %s instead of the expected %s", a, bb, e.Result(), expected)
+				}
+			}
+		}
+
+		e := Start(a)
+		if e.WithMaybeAsTrue() != a.WithMaybeAsTrue() || e.WithMaybeAsFalse() != a.WithMaybeAsFalse() {
+			t.Errorf("Start(%s) boolean coercions do not match", a)
+		}
+	}
+}
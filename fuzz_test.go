@@ -0,0 +1,50 @@
+package tribool
+
+import "testing"
+
+func FuzzFromStringRoundTrip(f *testing.F) {
+	for _, s := range []string{
+		"t", "T", "y", "1", "on", "yes", "true", "TRUE",
+		"f", "F", "n", "0", "no", "off", "false", "FALSE",
+		"maybe", "", " ", "huh?", "tru", "falsey", "\x00", "\xff\xfe",
+	} {
+		f.Add(s)
+	}
+	f.Fuzz(func(t *testing.T, s string) {
+		tri := FromString(s)
+		if tri < No || tri > Yes {
+			t.Fatalf("FromString(%q) => invalid state %d", s, int(tri))
+		}
+		if back := FromString(tri.String()); back != tri {
+			t.Errorf("FromString(%q) => %s, but FromString(%s) => %s", s, tri, tri, back)
+		}
+		if actual := FromBytes([]byte(s)); actual != tri {
+			t.Errorf("FromBytes(%q) => %s instead of FromString => %s", s, actual, tri)
+		}
+		if back, err := ParseStrict(tri.String()); err != nil || back != tri {
+			t.Errorf("ParseStrict(%s) => %s, %v instead of the expected %s", tri, back, err, tri)
+		}
+		if strict, err := ParseStrict(s); err == nil && strict != tri || err != nil && tri != Maybe {
+			t.Errorf("ParseStrict(%q) => %s, %v but FromString => %s", s, strict, err, tri)
+		}
+	})
+}
+
+func FuzzUnmarshalJSON(f *testing.F) {
+	for _, s := range []string{
+		`true`, `false`, `null`, `0`, `1`, `2`, `1.5`, `-0`, `1e400`,
+		`"yes"`, `"no"`, `"maybe"`, `""`, `"true"`,
+		`[]`, `{}`, `[true]`, `{"a":1}`, ``, `tru`, `"unterminated`, ` true `,
+	} {
+		f.Add([]byte(s))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var tri Tribool
+		if err := tri.UnmarshalJSON(data); err != nil {
+			return
+		}
+		if tri < No || tri > Yes {
+			t.Fatalf("UnmarshalJSON(%q) => invalid state %d", data, int(tri))
+		}
+	})
+}
@@ -0,0 +1,63 @@
+package tribool
+
+/*
+GlyphSet is the runes used to draw each state, such as in a status grid in a
+terminal. Declare one to use other glyphs, such as ASCII on terminals without
+Unicode:
+
+	ascii := tribool.GlyphSet{Yes: '+', Maybe: '?', No: '-'}
+	fmt.Printf("%c", ascii.Glyph(flag))
+*/
+type GlyphSet struct {
+	Yes, Maybe, No rune
+}
+
+/*
+DefaultGlyphSet returns the glyphs used by Tribool.Glyph and FromGlyph: ✓ for
+Yes, ? for Maybe, and ✗ for No.
+*/
+func DefaultGlyphSet() GlyphSet {
+	return GlyphSet{Yes: '✓', Maybe: '?', No: '✗'}
+}
+
+/*
+Glyph returns the rune for a.
+*/
+func (g GlyphSet) Glyph(a Tribool) rune {
+	switch a {
+	case yes:
+		return g.Yes
+	case no:
+		return g.No
+	}
+	return g.Maybe
+}
+
+/*
+FromGlyph converts a rune of the set back to a Tribool. Runes that are not in
+the set are Maybe.
+*/
+func (g GlyphSet) FromGlyph(r rune) Tribool {
+	switch r {
+	case g.Yes:
+		return yes
+	case g.No:
+		return no
+	}
+	return maybe
+}
+
+/*
+Glyph returns the rune for the Tribool from DefaultGlyphSet: ✓, ?, or ✗.
+*/
+func (a Tribool) Glyph() rune {
+	return DefaultGlyphSet().Glyph(a)
+}
+
+/*
+FromGlyph converts a rune from DefaultGlyphSet to a Tribool. Any other rune is
+Maybe.
+*/
+func FromGlyph(r rune) Tribool {
+	return DefaultGlyphSet().FromGlyph(r)
+}
@@ -0,0 +1,52 @@
+package tribool
+
+import "testing"
+
+func TestTribool_Glyph(t *testing.T) {
+	table := []struct {
+		a        Tribool
+		expected rune
+	}{
+		{Yes, '✓'}, {Maybe, '?'}, {No, '✗'},
+	}
+	for _, test := range table {
+		actual := test.a.Glyph()
+		if actual != test.expected {
+			t.Errorf("%s.Glyph() => %c instead of the expected %c", test.a, actual, test.expected)
+		}
+		if back := FromGlyph(actual); back != test.a {
+			t.Errorf("FromGlyph(%c) => %s instead of the expected %s", actual, back, test.a)
+		}
+	}
+
+	for _, r := range []rune{'Y', 'x', '✔', 0} {
+		if actual := FromGlyph(r); actual != Maybe {
+			t.Errorf("FromGlyph(%q) => %s instead of the expected maybe", r, actual)
+		}
+	}
+}
+
+func TestGlyphSet_custom(t *testing.T) {
+	ascii := GlyphSet{Yes: '+', Maybe: '.', No: '-'}
+	table := []struct {
+		a        Tribool
+		expected rune
+	}{
+		{Yes, '+'}, {Maybe, '.'}, {No, '-'},
+	}
+	for _, test := range table {
+		actual := ascii.Glyph(test.a)
+		if actual != test.expected {
+			t.Errorf("Glyph(%s) => %c instead of the expected %c", test.a, actual, test.expected)
+		}
+		if back := ascii.FromGlyph(actual); back != test.a {
+			t.Errorf("FromGlyph(%c) => %s instead of the expected %s", actual, back, test.a)
+		}
+	}
+
+	for _, r := range []rune{'✓', '✗', '?', '*'} {
+		if actual := ascii.FromGlyph(r); actual != Maybe {
+			t.Errorf("FromGlyph(%q) => %s instead of the expected maybe", r, actual)
+		}
+	}
+}
@@ -0,0 +1,15 @@
+package tribool
+
+import "encoding/gob"
+
+/*
+RegisterGob registers Tribool with encoding/gob, so that a Tribool can be
+encoded when it is held in an interface field, such as a value of a
+map[string]interface{}.
+
+Concrete Tribool fields and slices need no registration. In either case gob
+uses the single byte form of MarshalBinary.
+*/
+func RegisterGob() {
+	gob.Register(Tribool(0))
+}
@@ -0,0 +1,92 @@
+package tribool
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+)
+
+func TestTribool_MarshalBinary(t *testing.T) {
+	table := []struct {
+		tri      Tribool
+		expected byte
+	}{
+		{No, 0}, {Maybe, 1}, {Yes, 2},
+	}
+	for _, test := range table {
+		data, err := test.tri.MarshalBinary()
+		if err != nil {
+			t.Errorf("%s.MarshalBinary() returned error: %v", test.tri, err)
+		} else if len(data) != 1 || data[0] != test.expected {
+			t.Errorf("%s.MarshalBinary() => %x instead of the expected %x", test.tri, data, test.expected)
+		}
+
+		var actual Tribool
+		if err := actual.UnmarshalBinary(data); err != nil {
+			t.Errorf("UnmarshalBinary(%x) returned error: %v", data, err)
+		} else if actual != test.tri {
+			t.Errorf("UnmarshalBinary(%x) => %s instead of the expected %s", data, actual, test.tri)
+		}
+	}
+
+	for _, a := range []Tribool{Tribool(-1), Tribool(3), Tribool(7)} {
+		if data, err := a.MarshalBinary(); err == nil {
+			t.Errorf("Tribool(%d).MarshalBinary() => %x instead of an error", int(a), data)
+		}
+	}
+
+	for _, data := range [][]byte{nil, {}, {3}, {0xff}, {0, 1}} {
+		var actual Tribool
+		if err := actual.UnmarshalBinary(data); err == nil {
+			t.Errorf("UnmarshalBinary(%x) => %s instead of an error", data, actual)
+		}
+	}
+}
+
+func TestTribool_gob(t *testing.T) {
+	type record struct {
+		Name   string
+		Flag   Tribool
+		Other  Tribool
+		Checks []Tribool
+	}
+	expected := record{"r", Maybe, Yes, []Tribool{Yes, No, Maybe, Yes}}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(expected); err != nil {
+		t.Fatalf("Encoding %v returned error: %v", expected, err)
+	}
+	var actual record
+	if err := gob.NewDecoder(&buf).Decode(&actual); err != nil {
+		t.Fatalf("Decoding returned error: %v", err)
+	}
+	if actual.Name != expected.Name || actual.Flag != expected.Flag || actual.Other != expected.Other {
+		t.Errorf("gob round trip => %v instead of the expected %v", actual, expected)
+	}
+	assertSlice(t, "gob round trip", actual.Checks, expected.Checks)
+
+	// a corrupt value is an error, not a panic
+	corrupt := record{Flag: Tribool(7)}
+	if err := gob.NewEncoder(&buf).Encode(corrupt); err == nil {
+		t.Errorf("Encoding a record holding Tribool(7) should return an error")
+	}
+}
+
+func TestRegisterGob(t *testing.T) {
+	RegisterGob()
+
+	expected := map[string]interface{}{"yes": Yes, "maybe": Maybe, "no": No}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(expected); err != nil {
+		t.Fatalf("Encoding %v returned error: %v", expected, err)
+	}
+	var actual map[string]interface{}
+	if err := gob.NewDecoder(&buf).Decode(&actual); err != nil {
+		t.Fatalf("Decoding returned error: %v", err)
+	}
+	for k, v := range expected {
+		if actual[k] != v {
+			t.Errorf("gob round trip of %s => %#v instead of the expected %#v", k, actual[k], v)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package tribool
+
+/*
+Hysteresis turns a noisy stream of successes and failures into a stable
+Tribool. It starts as Maybe, becomes Yes after up consecutive successes, and
+becomes No after down consecutive failures. Once Yes or No, it keeps that state
+until the opposite threshold is reached, so a single contrary result does not
+make it flap.
+*/
+type Hysteresis struct {
+	up, down  int
+	successes int // consecutive successes so far
+	failures  int // consecutive failures so far
+	state     Tribool
+}
+
+/*
+NewHysteresis returns a Hysteresis in the Maybe state with the given thresholds.
+It panics if up or down is less than 1.
+*/
+func NewHysteresis(up, down int) *Hysteresis {
+	if up < 1 || down < 1 {
+		panic("tribool: hysteresis thresholds must be at least 1")
+	}
+	return &Hysteresis{up: up, down: down, state: maybe}
+}
+
+/*
+Update records one result and returns the new state.
+*/
+func (h *Hysteresis) Update(success bool) Tribool {
+	if success {
+		h.successes++
+		h.failures = 0
+		if h.successes >= h.up {
+			h.state = yes
+		}
+	} else {
+		h.failures++
+		h.successes = 0
+		if h.failures >= h.down {
+			h.state = no
+		}
+	}
+	return h.state
+}
+
+/*
+State returns the current state without recording a result.
+*/
+func (h *Hysteresis) State() Tribool {
+	return h.state
+}
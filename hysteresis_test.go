@@ -0,0 +1,53 @@
+package tribool
+
+import "testing"
+
+func TestHysteresis(t *testing.T) {
+	N, x, Y := No, Maybe, Yes
+	const T, F = true, false
+	table := []struct {
+		up, down int
+		results  []bool
+		expected []Tribool
+	}{
+		// the indeterminate middle before either threshold
+		{3, 2, []bool{T, F, T, T, F}, []Tribool{x, x, x, x, x}},
+
+		// up through the high watermark, then down through the low
+		{3, 2, []bool{T, T, T, F, T, F, F, T, T, T},
+			[]Tribool{x, x, Y, Y, Y, Y, N, N, N, Y}},
+
+		// straight to No
+		{3, 2, []bool{F, F, F}, []Tribool{x, N, N}},
+
+		// thresholds of one follow the input
+		{1, 1, []bool{T, F, T}, []Tribool{Y, N, Y}},
+	}
+	for _, test := range table {
+		h := NewHysteresis(test.up, test.down)
+		if h.State() != x {
+			t.Errorf("NewHysteresis(%d, %d).State() => %s instead of the expected maybe", test.up, test.down, h.State())
+		}
+		actual := make([]Tribool, len(test.results))
+		for i, success := range test.results {
+			actual[i] = h.Update(success)
+		}
+		assertSlice(t, "Hysteresis", actual, test.expected)
+		if h.State() != actual[len(actual)-1] {
+			t.Errorf("State() => %s instead of the last update %s", h.State(), actual[len(actual)-1])
+		}
+	}
+}
+
+func TestNewHysteresis_invalid(t *testing.T) {
+	for _, thresholds := range [][2]int{{0, 1}, {1, 0}, {-1, 3}} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("NewHysteresis(%d, %d) should panic", thresholds[0], thresholds[1])
+				}
+			}()
+			NewHysteresis(thresholds[0], thresholds[1])
+		}()
+	}
+}
@@ -0,0 +1,269 @@
+package tribool
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+/*
+FromJSONValue converts a value decoded by encoding/json into an interface{},
+such as an element of a map[string]interface{}, the same way UnmarshalJSON
+converts the JSON:
+
+	      value | result
+	------------+---------------------------------------
+	        nil | Maybe
+	       bool | FromBool
+	     string | FromString
+	    float64 | zero is No, other whole numbers are Yes, fractions are Maybe
+	json.Number | the same as float64, for a Decoder with UseNumber
+
+Any other value, such as an object or array, is Maybe.
+*/
+func FromJSONValue(v interface{}) Tribool {
+	switch v := v.(type) {
+	case bool:
+		return FromBool(v)
+	case string:
+		return FromString(v)
+	case float64:
+		return fromNumber(v)
+	case json.Number:
+		// A number too large for a float64 is ±Inf, which is still Yes.
+		if f, err := v.Float64(); err == nil || errors.Is(err, strconv.ErrRange) {
+			return fromNumber(f)
+		}
+	}
+	return maybe
+}
+
+/*
+BoolOrNull is a Tribool that is encoded in JSON as a boolean or null rather
+than as a string.
+
+	Tribool | JSON
+	--------+------
+	    Yes | true
+	  Maybe | null
+	     No | false
+
+Convert to and from a Tribool with a plain conversion:
+
+	var flag tribool.BoolOrNull = tribool.BoolOrNull(t)
+	t = tribool.Tribool(flag)
+*/
+type BoolOrNull Tribool
+
+var (
+	jsonTrue  = []byte("true")
+	jsonFalse = []byte("false")
+	jsonNull  = []byte("null")
+)
+
+// MarshalJSON marshals Yes to true, No to false, and Maybe to null.
+func (a BoolOrNull) MarshalJSON() ([]byte, error) {
+	switch Tribool(a) {
+	case yes:
+		return []byte("true"), nil
+	case no:
+		return []byte("false"), nil
+	}
+	return []byte("null"), nil
+}
+
+// UnmarshalJSON unmarshals true to Yes and false to No. Anything else,
+// including null, is treated as Maybe.
+func (a *BoolOrNull) UnmarshalJSON(data []byte) error {
+	if a == nil {
+		return errors.New("tribool.BoolOrNull: UnmarshalJSON on nil pointer")
+	}
+	switch {
+	case bytes.Equal(data, jsonTrue):
+		*a = BoolOrNull(yes)
+	case bytes.Equal(data, jsonFalse):
+		*a = BoolOrNull(no)
+	default:
+		*a = BoolOrNull(maybe)
+	}
+	return nil
+}
+
+/*
+StrictTribool is a Tribool that only accepts the canonical words "yes", "no",
+and "maybe" in JSON, so that it validates like a string enum in a JSON or
+OpenAPI schema. A plain Tribool parses any string with FromString and treats
+unknown words as Maybe.
+
+Convert to and from a Tribool with a plain conversion:
+
+	var flag tribool.StrictTribool = tribool.StrictTribool(t)
+	t = tribool.Tribool(flag)
+*/
+type StrictTribool Tribool
+
+// MarshalJSON marshals to the same canonical words as `Tribool.MarshalJSON()`.
+func (a StrictTribool) MarshalJSON() ([]byte, error) {
+	return Tribool(a).MarshalJSON()
+}
+
+// UnmarshalJSON unmarshals exactly the strings "yes", "no", and "maybe". A
+// json null leaves the value unchanged; anything else is an error.
+func (a *StrictTribool) UnmarshalJSON(data []byte) error {
+	if a == nil {
+		return errors.New("tribool.StrictTribool: UnmarshalJSON on nil pointer")
+	}
+	if bytes.Equal(data, jsonNull) {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("tribool.StrictTribool: cannot unmarshal %s into a StrictTribool", data)
+	}
+	for _, v := range values {
+		if s == words[v] {
+			*a = StrictTribool(v)
+			return nil
+		}
+	}
+	return fmt.Errorf("tribool.StrictTribool: %q is not one of \"yes\", \"no\", or \"maybe\"", s)
+}
+
+/*
+Optional is a Tribool whose zero value is Maybe, and which treats Maybe as unset
+in JSON.
+
+A plain Tribool field tagged with omitempty is omitted when it is No, because
+No is the zero value, so false values silently disappear from the output:
+
+	type Config struct {
+		Feature tribool.Tribool `json:"feature,omitempty"` // No is dropped!
+	}
+
+Use Optional with omitzero instead, so that only Maybe is omitted:
+
+	type Config struct {
+		Feature tribool.Optional `json:"feature,omitzero"` // Maybe is dropped
+	}
+
+Without omitzero, Maybe is written as null. Optional has the same methods as
+MaybeDefault.
+*/
+type Optional struct {
+	MaybeDefault
+}
+
+/*
+NewOptional converts a Tribool to an Optional.
+*/
+func NewOptional(a Tribool) Optional {
+	return Optional{NewMaybeDefault(a)}
+}
+
+// IsZero reports whether o is Maybe, for use with the omitzero JSON option.
+func (o Optional) IsZero() bool {
+	return o.Tribool() == maybe
+}
+
+// MarshalJSON marshals Maybe to null and the other states to strings, using
+// the Tribool.String() method.
+func (o Optional) MarshalJSON() ([]byte, error) {
+	if o.IsZero() {
+		return []byte("null"), nil
+	}
+	return o.Tribool().MarshalJSON()
+}
+
+/*
+RawTribool is a Tribool that remembers the JSON it was decoded from, and encodes
+to exactly that JSON again, like json.RawMessage. This keeps proxied payloads
+byte-for-byte unchanged, so "TRUE", "true", and true each survive a round trip.
+
+A RawTribool that was not decoded from JSON, such as one made by NewRawTribool,
+encodes like a Tribool.
+*/
+type RawTribool struct {
+	a   Tribool
+	raw []byte
+}
+
+/*
+NewRawTribool converts a Tribool to a RawTribool with no original JSON.
+*/
+func NewRawTribool(a Tribool) RawTribool {
+	return RawTribool{a: a}
+}
+
+/*
+Value returns the parsed state.
+*/
+func (r RawTribool) Value() Tribool {
+	return r.a
+}
+
+// MarshalJSON returns the original JSON verbatim, or marshals the value using
+// `Tribool.MarshalJSON()` if there is none.
+func (r RawTribool) MarshalJSON() ([]byte, error) {
+	if r.raw == nil {
+		return r.a.MarshalJSON()
+	}
+	return r.raw, nil
+}
+
+// UnmarshalJSON parses data using `Tribool.UnmarshalJSON()` and keeps a copy of
+// it for MarshalJSON.
+func (r *RawTribool) UnmarshalJSON(data []byte) error {
+	if r == nil {
+		return errors.New("tribool.RawTribool: UnmarshalJSON on nil pointer")
+	}
+	var a Tribool
+	if err := a.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	r.a, r.raw = a, bytes.Clone(data)
+	return nil
+}
+
+/*
+Packed is a slice of Tribools that is encoded in JSON as a single string of
+their symbols, which is much smaller than an array of strings:
+
+	tribool.Packed{tribool.Yes, tribool.No, tribool.Maybe, tribool.Yes} // "YN?Y"
+*/
+type Packed []Tribool
+
+// MarshalJSON marshals the slice to a string of symbols, using the
+// Tribool.Symbol() method.
+func (p Packed) MarshalJSON() ([]byte, error) {
+	buf := make([]byte, 0, len(p)+2)
+	buf = append(buf, '"')
+	for _, a := range p {
+		buf = a.AppendSymbol(buf)
+	}
+	buf = append(buf, '"')
+	return buf, nil
+}
+
+// UnmarshalJSON unmarshals a string of symbols, using `FromSymbol()` for each
+// character, so unknown characters are `Maybe`. A json null leaves the slice
+// unchanged; anything else is an error.
+func (p *Packed) UnmarshalJSON(data []byte) error {
+	if p == nil {
+		return errors.New("tribool.Packed: UnmarshalJSON on nil pointer")
+	}
+	if bytes.Equal(data, jsonNull) {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	out := make(Packed, 0, len(s))
+	for _, r := range s {
+		out = append(out, FromSymbol(string(r)))
+	}
+	*p = out
+	return nil
+}
@@ -0,0 +1,392 @@
+package tribool
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestBoolOrNull_MarshalJSON(t *testing.T) {
+	table := []struct {
+		tri      Tribool
+		expected string
+	}{
+		{Yes, `true`},
+		{Maybe, `null`},
+		{No, `false`},
+	}
+	for _, test := range table {
+		jsonBytes, err := json.Marshal(BoolOrNull(test.tri))
+		if err != nil {
+			t.Errorf("Marshalling %v returned error: %v", test.tri, err)
+		} else if string(jsonBytes) != test.expected {
+			t.Errorf("json.Marshal(BoolOrNull(%v)) => %s instead of the expected %s", test.tri, string(jsonBytes), test.expected)
+		}
+	}
+}
+
+func TestBoolOrNull_MarshalJSON_fresh(t *testing.T) {
+	// modifying the result must not affect later decoding
+	for _, a := range values {
+		b, _ := BoolOrNull(a).MarshalJSON()
+		b[0] = 'X'
+	}
+	for _, test := range []struct {
+		jsonString string
+		expected   Tribool
+	}{{`true`, Yes}, {`false`, No}, {`null`, Maybe}} {
+		var tri Tribool
+		if err := json.Unmarshal([]byte(test.jsonString), &tri); err != nil || tri != test.expected {
+			t.Errorf("json.Unmarshal(%s) after modifying marshalled bytes => %s, %v", test.jsonString, tri, err)
+		}
+		if again, _ := BoolOrNull(test.expected).MarshalJSON(); string(again) != test.jsonString {
+			t.Errorf("BoolOrNull(%s).MarshalJSON() => %s after modifying an earlier result", test.expected, again)
+		}
+	}
+}
+
+func TestBoolOrNull_UnmarshalJSON(t *testing.T) {
+	table := []struct {
+		jsonString string
+		expected   Tribool
+	}{
+		{`true`, True}, {`false`, False}, {`null`, Maybe},
+		{`"true"`, Maybe}, {`"yes"`, Maybe}, {`1`, Maybe},
+		{`{"an":"object"}`, Maybe}, {`[true]`, Maybe},
+	}
+	for _, test := range table {
+		var tri BoolOrNull
+		err := json.Unmarshal([]byte(test.jsonString), &tri)
+		if err != nil {
+			t.Errorf("Unmarshalling %s returned error: %v", test.jsonString, err)
+		} else if Tribool(tri) != test.expected {
+			t.Errorf("json.Unmarshal(%s) => %v instead of the expected %v", test.jsonString, Tribool(tri), test.expected)
+		}
+	}
+}
+
+func TestBoolOrNull_roundTrip(t *testing.T) {
+	type payload struct {
+		Flag BoolOrNull `json:"flag"`
+	}
+	for _, tri := range values {
+		jsonBytes, err := json.Marshal(payload{BoolOrNull(tri)})
+		if err != nil {
+			t.Errorf("Marshalling %v returned error: %v", tri, err)
+			continue
+		}
+		// start from a different state to be sure null overwrites it
+		actual := payload{BoolOrNull(tri.Not())}
+		if tri == Maybe {
+			actual.Flag = BoolOrNull(Yes)
+		}
+		if err := json.Unmarshal(jsonBytes, &actual); err != nil {
+			t.Errorf("Unmarshalling %s returned error: %v", jsonBytes, err)
+		} else if Tribool(actual.Flag) != tri {
+			t.Errorf("%v => %s => %v instead of the expected %v", tri, jsonBytes, Tribool(actual.Flag), tri)
+		}
+	}
+}
+
+func TestOptional_MarshalJSON(t *testing.T) {
+	type plain struct {
+		Flag Tribool `json:"flag,omitempty"`
+	}
+	type optional struct {
+		Flag Optional `json:"flag,omitzero"`
+	}
+	type nullable struct {
+		Flag Optional `json:"flag"`
+	}
+	table := []struct {
+		tri                       Tribool
+		plain, optional, nullable string
+	}{
+		{Yes, `{"flag":"yes"}`, `{"flag":"yes"}`, `{"flag":"yes"}`},
+		{No, `{}`, `{"flag":"no"}`, `{"flag":"no"}`},
+		{Maybe, `{"flag":"maybe"}`, `{}`, `{"flag":null}`},
+	}
+	for _, test := range table {
+		for _, c := range []struct {
+			v        interface{}
+			expected string
+		}{
+			{plain{test.tri}, test.plain},
+			{optional{NewOptional(test.tri)}, test.optional},
+			{nullable{NewOptional(test.tri)}, test.nullable},
+		} {
+			jsonBytes, err := json.Marshal(c.v)
+			if err != nil {
+				t.Errorf("Marshalling %#v returned error: %v", c.v, err)
+			} else if string(jsonBytes) != c.expected {
+				t.Errorf("json.Marshal(%#v) => %s instead of the expected %s", c.v, jsonBytes, c.expected)
+			}
+		}
+	}
+
+	var zero optional
+	if jsonBytes, _ := json.Marshal(zero); string(jsonBytes) != `{}` {
+		t.Errorf("json.Marshal of a zero Optional => %s instead of the expected {}", jsonBytes)
+	}
+
+	// modifying the result must not affect later decoding
+	b, _ := NewOptional(Maybe).MarshalJSON()
+	b[0] = 'X'
+	var tri Tribool
+	if err := json.Unmarshal([]byte(`null`), &tri); err != nil || tri != Maybe {
+		t.Errorf("json.Unmarshal(null) after modifying marshalled bytes => %s, %v", tri, err)
+	}
+}
+
+func TestOptional_UnmarshalJSON(t *testing.T) {
+	type optional struct {
+		Flag Optional `json:"flag,omitzero"`
+	}
+	table := []struct {
+		jsonString string
+		expected   Tribool
+	}{
+		{`{}`, Maybe},
+		{`{"flag":null}`, Maybe},
+		{`{"flag":"maybe"}`, Maybe},
+		{`{"flag":"no"}`, No},
+		{`{"flag":false}`, No},
+		{`{"flag":"yes"}`, Yes},
+	}
+	for _, test := range table {
+		var actual optional
+		if err := json.Unmarshal([]byte(test.jsonString), &actual); err != nil {
+			t.Errorf("Unmarshalling %s returned error: %v", test.jsonString, err)
+			continue
+		}
+		if actual.Flag.Tribool() != test.expected {
+			t.Errorf("json.Unmarshal(%s) => %s instead of the expected %s", test.jsonString, actual.Flag, test.expected)
+		}
+		if actual.Flag.IsZero() != (test.expected == Maybe) {
+			t.Errorf("json.Unmarshal(%s).IsZero() => %v", test.jsonString, actual.Flag.IsZero())
+		}
+	}
+}
+
+func TestPacked_roundTrip(t *testing.T) {
+	N, x, Y := No, Maybe, Yes
+	table := []struct {
+		packed   Packed
+		expected string
+	}{
+		{Packed{}, `""`},
+		{Packed{Y}, `"Y"`},
+		{Packed{Y, N, x, Y}, `"YN?Y"`},
+		{Packed{x, x, N, N, Y, Y, x}, `"??NNYY?"`},
+	}
+	for _, test := range table {
+		jsonBytes, err := json.Marshal(test.packed)
+		if err != nil {
+			t.Errorf("Marshalling %v returned error: %v", test.packed, err)
+			continue
+		}
+		if string(jsonBytes) != test.expected {
+			t.Errorf("json.Marshal(%v) => %s instead of the expected %s", test.packed, jsonBytes, test.expected)
+		}
+		var actual Packed
+		if err := json.Unmarshal(jsonBytes, &actual); err != nil {
+			t.Errorf("Unmarshalling %s returned error: %v", jsonBytes, err)
+			continue
+		}
+		assertSlice(t, "json.Unmarshal("+string(jsonBytes)+")", actual, test.packed)
+	}
+}
+
+func TestPacked_UnmarshalJSON(t *testing.T) {
+	N, x, Y := No, Maybe, Yes
+	table := []struct {
+		jsonString string
+		expected   []Tribool
+	}{
+		{`"Yxn?N"`, []Tribool{Y, x, x, x, N}},
+		{`"✓Y"`, []Tribool{x, Y}},
+		{`"y"`, []Tribool{x}},
+	}
+	for _, test := range table {
+		var actual Packed
+		if err := json.Unmarshal([]byte(test.jsonString), &actual); err != nil {
+			t.Errorf("Unmarshalling %s returned error: %v", test.jsonString, err)
+			continue
+		}
+		assertSlice(t, "json.Unmarshal("+test.jsonString+")", actual, test.expected)
+	}
+
+	for _, jsonString := range []string{`["Y"]`, `true`, `1`} {
+		var actual Packed
+		if err := json.Unmarshal([]byte(jsonString), &actual); err == nil {
+			t.Errorf("json.Unmarshal(%s) => %v instead of an error", jsonString, actual)
+		}
+	}
+}
+
+func TestTribool_mapKeyJSON(t *testing.T) {
+	tally := map[Tribool]int{Yes: 3, Maybe: 2, No: 1}
+	jsonBytes, err := json.Marshal(tally)
+	if err != nil {
+		t.Fatalf("Marshalling %v returned error: %v", tally, err)
+	}
+	expected := `{"maybe":2,"no":1,"yes":3}`
+	if string(jsonBytes) != expected {
+		t.Errorf("json.Marshal(%v) => %s instead of the expected %s", tally, jsonBytes, expected)
+	}
+
+	var actual map[Tribool]int
+	if err := json.Unmarshal(jsonBytes, &actual); err != nil {
+		t.Fatalf("Unmarshalling %s returned error: %v", jsonBytes, err)
+	}
+	if len(actual) != len(tally) {
+		t.Errorf("json.Unmarshal(%s) => %v instead of the expected %v", jsonBytes, actual, tally)
+	}
+	for k, v := range tally {
+		if actual[k] != v {
+			t.Errorf("json.Unmarshal(%s)[%s] => %d instead of the expected %d", jsonBytes, k, actual[k], v)
+		}
+	}
+
+	// keys are parsed with FromString
+	if err := json.Unmarshal([]byte(`{"TRUE":1,"off":2,"unknown":3}`), &actual); err != nil {
+		t.Fatalf("Unmarshalling returned error: %v", err)
+	}
+	if actual[Yes] != 1 || actual[No] != 2 || actual[Maybe] != 3 {
+		t.Errorf("json.Unmarshal of synonym keys => %v", actual)
+	}
+}
+
+func TestStrictTribool_JSON(t *testing.T) {
+	table := []struct {
+		jsonString string
+		expected   Tribool
+	}{
+		{`"yes"`, Yes}, {`"no"`, No}, {`"maybe"`, Maybe},
+	}
+	for _, test := range table {
+		var actual StrictTribool
+		if err := json.Unmarshal([]byte(test.jsonString), &actual); err != nil {
+			t.Errorf("Unmarshalling %s returned error: %v", test.jsonString, err)
+		} else if Tribool(actual) != test.expected {
+			t.Errorf("json.Unmarshal(%s) => %s instead of the expected %s", test.jsonString, Tribool(actual), test.expected)
+		}
+
+		jsonBytes, err := json.Marshal(StrictTribool(test.expected))
+		if err != nil {
+			t.Errorf("Marshalling %s returned error: %v", test.expected, err)
+		} else if string(jsonBytes) != test.jsonString {
+			t.Errorf("json.Marshal(StrictTribool(%s)) => %s instead of the expected %s", test.expected, jsonBytes, test.jsonString)
+		}
+	}
+
+	// null leaves the value unchanged
+	actual := StrictTribool(Yes)
+	if err := json.Unmarshal([]byte(`null`), &actual); err != nil || Tribool(actual) != Yes {
+		t.Errorf("json.Unmarshal(null) => %s, %v instead of leaving yes unchanged", Tribool(actual), err)
+	}
+}
+
+func TestStrictTribool_UnmarshalJSON_invalid(t *testing.T) {
+	for _, jsonString := range []string{`"YES"`, `"true"`, `"on"`, `"unknown"`, `""`, `true`, `1`, `["yes"]`} {
+		var strict StrictTribool
+		if err := json.Unmarshal([]byte(jsonString), &strict); err == nil {
+			t.Errorf("json.Unmarshal(%s) into a StrictTribool => %s instead of an error", jsonString, Tribool(strict))
+		}
+	}
+
+	// the same strings are coerced by a plain Tribool
+	for _, jsonString := range []string{`"YES"`, `"true"`, `"on"`, `"unknown"`, `""`} {
+		var plain Tribool
+		if err := json.Unmarshal([]byte(jsonString), &plain); err != nil {
+			t.Errorf("json.Unmarshal(%s) into a Tribool returned error: %v", jsonString, err)
+		}
+	}
+}
+
+func TestRawTribool_roundTrip(t *testing.T) {
+	type payload struct {
+		Flag RawTribool `json:"flag"`
+	}
+	table := []struct {
+		jsonString string
+		expected   Tribool
+	}{
+		{`{"flag":"TRUE"}`, Yes},
+		{`{"flag":"true"}`, Yes},
+		{`{"flag":true}`, Yes},
+		{`{"flag":1}`, Yes},
+		{`{"flag":1.0}`, Yes},
+		{`{"flag":"Off"}`, No},
+		{`{"flag":0}`, No},
+		{`{"flag":null}`, Maybe},
+		{`{"flag":"unknown"}`, Maybe},
+		{`{"flag":"yes"}`, Yes},
+	}
+	for _, test := range table {
+		var actual payload
+		if err := json.Unmarshal([]byte(test.jsonString), &actual); err != nil {
+			t.Errorf("Unmarshalling %s returned error: %v", test.jsonString, err)
+			continue
+		}
+		if actual.Flag.Value() != test.expected {
+			t.Errorf("json.Unmarshal(%s).Value() => %s instead of the expected %s", test.jsonString, actual.Flag.Value(), test.expected)
+		}
+		jsonBytes, err := json.Marshal(actual)
+		if err != nil {
+			t.Errorf("Marshalling %s returned error: %v", test.jsonString, err)
+		} else if string(jsonBytes) != test.jsonString {
+			t.Errorf("%s => %s instead of the same bytes", test.jsonString, jsonBytes)
+		}
+	}
+
+	var bad payload
+	if err := json.Unmarshal([]byte(`{"flag":[true]}`), &bad); err == nil {
+		t.Errorf("json.Unmarshal of an array into a RawTribool => %s instead of an error", bad.Flag.Value())
+	}
+}
+
+func TestNewRawTribool(t *testing.T) {
+	for _, a := range values {
+		r := NewRawTribool(a)
+		if r.Value() != a {
+			t.Errorf("NewRawTribool(%s).Value() => %s", a, r.Value())
+		}
+		actual, _ := json.Marshal(r)
+		expected, _ := json.Marshal(a)
+		if string(actual) != string(expected) {
+			t.Errorf("json.Marshal(NewRawTribool(%s)) => %s instead of the expected %s", a, actual, expected)
+		}
+	}
+}
+
+func TestFromJSONValue(t *testing.T) {
+	table := []struct {
+		v        interface{}
+		expected Tribool
+	}{
+		{nil, Maybe},
+		{true, Yes}, {false, No},
+		{"yes", Yes}, {"off", No}, {"maybe", Maybe}, {"asdf", Maybe},
+		{float64(0), No}, {float64(1), Yes}, {float64(-2), Yes}, {1.5, Maybe},
+		{json.Number("0"), No}, {json.Number("7"), Yes}, {json.Number("0.5"), Maybe},
+		{json.Number("1e400"), Yes}, {json.Number("-1e400"), Yes},
+		{json.Number("bogus"), Maybe},
+		{map[string]interface{}{}, Maybe}, {[]interface{}{true}, Maybe}, {1, Maybe},
+	}
+	for _, test := range table {
+		if actual := FromJSONValue(test.v); actual != test.expected {
+			t.Errorf("FromJSONValue(%#v) => %s instead of the expected %s", test.v, actual, test.expected)
+		}
+	}
+
+	// decoded values agree with UnmarshalJSON
+	for _, jsonString := range []string{`true`, `false`, `null`, `"yes"`, `"no"`, `0`, `1`, `2.5`, `1e400`, `-1e400`} {
+		var v interface{}
+		var expected Tribool
+		json.Unmarshal([]byte(jsonString), &v)
+		json.Unmarshal([]byte(jsonString), &expected)
+		if actual := FromJSONValue(v); actual != expected {
+			t.Errorf("FromJSONValue(%s) => %s but json.Unmarshal => %s", jsonString, actual, expected)
+		}
+	}
+}
@@ -0,0 +1,284 @@
+package tribool
+
+import "fmt"
+
+/*
+MaxArity is the largest number of variables the exhaustive checks in this
+package, such as IsTautology, will enumerate.
+*/
+const MaxArity = 10
+
+/*
+IsTautology reports whether f returns Yes for every assignment of No, Maybe,
+and Yes to its arity arguments.
+
+Under Kleene semantics few expressions are tautologies: a.Or(a.Not()) is Maybe
+when a is Maybe, so it is not one.
+
+IsTautology calls f 3^arity times, so its cost grows exponentially. It panics
+if arity is negative or greater than MaxArity. The slice passed to f is reused
+between calls and must not be retained.
+*/
+func IsTautology(f func([]Tribool) Tribool, arity int) bool {
+	return forEachAssignment(values[:], arity, func(vs []Tribool) bool {
+		return f(vs) == yes
+	})
+}
+
+/*
+IsContradiction reports whether f returns No for every assignment of No, Maybe,
+and Yes to its arity arguments.
+
+IsContradiction has the same cost and restrictions as IsTautology.
+*/
+func IsContradiction(f func([]Tribool) Tribool, arity int) bool {
+	return forEachAssignment(values[:], arity, func(vs []Tribool) bool {
+		return f(vs) == no
+	})
+}
+
+var knownValues = [2]Tribool{No, Yes}
+
+/*
+Possibly reports whether f returns Yes for some assignment of No and Yes to its
+arity arguments. Each argument is treated as an unknown that resolves to a
+two-valued truth, so Maybe is never passed to f.
+
+Possibly calls f up to 2^arity times. It panics if arity is negative or greater
+than MaxArity. The slice passed to f is reused between calls and must not be
+retained.
+*/
+func Possibly(f func([]Tribool) Tribool, arity int) bool {
+	return !forEachAssignment(knownValues[:], arity, func(vs []Tribool) bool {
+		return f(vs) != yes
+	})
+}
+
+/*
+Necessarily reports whether f returns Yes for every assignment of No and Yes to
+its arity arguments.
+
+Unlike IsTautology, Necessarily does not pass Maybe to f, so a.Or(a.Not()) is
+necessarily true.
+
+Necessarily has the same cost and restrictions as Possibly.
+*/
+func Necessarily(f func([]Tribool) Tribool, arity int) bool {
+	return forEachAssignment(knownValues[:], arity, func(vs []Tribool) bool {
+		return f(vs) == yes
+	})
+}
+
+/*
+Resolutions returns every way of resolving the Maybes in vs to two-valued
+truths. Each known value is fixed, and each Maybe is replaced by both false and
+true, so with k Maybes in vs it returns 2^k slices, each as long as vs. It is
+the same enumeration that Possibly and Necessarily perform over their
+arguments.
+
+The result grows exponentially with the number of Maybes. Resolutions panics if
+vs holds more than MaxArity of them.
+*/
+func Resolutions(vs []Tribool) [][]bool {
+	var unknowns []int
+	for i, a := range vs {
+		if a == maybe {
+			unknowns = append(unknowns, i)
+		}
+	}
+	if len(unknowns) > MaxArity {
+		panic(fmt.Sprintf("tribool: %d unknowns is more than MaxArity (%d)", len(unknowns), MaxArity))
+	}
+
+	out := make([][]bool, 0, 1<<len(unknowns))
+	forEachAssignment(knownValues[:], len(unknowns), func(guesses []Tribool) bool {
+		resolution := make([]bool, len(vs))
+		for i, a := range vs {
+			resolution[i] = a == yes
+		}
+		for i, j := range unknowns {
+			resolution[j] = guesses[i] == yes
+		}
+		out = append(out, resolution)
+		return true
+	})
+	return out
+}
+
+/*
+IsInformative reports whether resolving a would give new information, which is
+only when a is Maybe. Yes and No are already known.
+*/
+func (a Tribool) IsInformative() bool {
+	return a == maybe
+}
+
+/*
+EntropyBits measures how much is unknown about vs, in bits. Each Maybe could
+resolve to either false or true, so it is one bit of missing information, and
+the result is the number of Maybes in vs. It is the base 2 logarithm of the
+number of slices Resolutions(vs) would return.
+*/
+func EntropyBits(vs []Tribool) float64 {
+	var bits float64
+	for _, v := range vs {
+		if v.IsInformative() {
+			bits++
+		}
+	}
+	return bits
+}
+
+/*
+TruthTable evaluates op over every pair of states. The result is indexed by the
+arguments in the order No, Maybe, Yes, so TruthTable(op)[0][2] is op(No, Yes).
+*/
+func TruthTable(op func(a, b Tribool) Tribool) [3][3]Tribool {
+	var table [3][3]Tribool
+	for i, a := range values {
+		for j, b := range values {
+			table[i][j] = op(a, b)
+		}
+	}
+	return table
+}
+
+/*
+FormatTruthTable renders the truth table of op in the same layout as the tables
+in this package's documentation:
+
+	a b | op(a, b)
+	----+---------
+	N N | N
+	N ? | N
+	...
+*/
+func FormatTruthTable(op func(a, b Tribool) Tribool) string {
+	buf := []byte("a b | op(a, b)\n----+---------\n")
+	table := TruthTable(op)
+	for i, a := range values {
+		for j, b := range values {
+			buf = a.AppendSymbol(buf)
+			buf = append(buf, ' ')
+			buf = b.AppendSymbol(buf)
+			buf = append(buf, " | "...)
+			buf = table[i][j].AppendSymbol(buf)
+			buf = append(buf, '\n')
+		}
+	}
+	return string(buf)
+}
+
+/*
+Dual returns the De Morgan dual of op, which is ¬op(¬a, ¬b). And and Or are
+each other's duals, as are Nand and Nor, and Xor and Equiv, so
+TruthTable(Dual(Tribool.And)) equals TruthTable(Tribool.Or).
+*/
+func Dual(op func(a, b Tribool) Tribool) func(a, b Tribool) Tribool {
+	return func(a, b Tribool) Tribool {
+		return op(a.Not(), b.Not()).Not()
+	}
+}
+
+/*
+Complement returns the operator ¬op(a, b), so Complement(Tribool.And) is Nand
+and Complement(Tribool.Or) is Nor.
+*/
+func Complement(op func(a, b Tribool) Tribool) func(a, b Tribool) Tribool {
+	return func(a, b Tribool) Tribool {
+		return op(a, b).Not()
+	}
+}
+
+/*
+IsCommutative reports whether op(a, b) == op(b, a) for every pair of states.
+*/
+func IsCommutative(op func(a, b Tribool) Tribool) bool {
+	return forEachAssignment(values[:], 2, func(vs []Tribool) bool {
+		return op(vs[0], vs[1]) == op(vs[1], vs[0])
+	})
+}
+
+/*
+IsAssociative reports whether op(op(a, b), c) == op(a, op(b, c)) for every
+triple of states.
+*/
+func IsAssociative(op func(a, b Tribool) Tribool) bool {
+	return forEachAssignment(values[:], 3, func(vs []Tribool) bool {
+		a, b, c := vs[0], vs[1], vs[2]
+		return op(op(a, b), c) == op(a, op(b, c))
+	})
+}
+
+/*
+Logic selects which states count as true, or designated, when deciding
+entailment. The connectives are the same in both logics; only the designated
+states differ.
+*/
+type Logic int
+
+const (
+	// K3 is Kleene's strong three-valued logic, where only Yes is designated.
+	K3 Logic = iota
+
+	// LP is Priest's Logic of Paradox, where Yes and Maybe are designated.
+	LP
+)
+
+/*
+Designated reports whether a counts as true under the logic.
+*/
+func (l Logic) Designated(a Tribool) bool {
+	if l == LP {
+		return a != no
+	}
+	return a == yes
+}
+
+/*
+Entails reports whether the premises entail the conclusion under the logic: that
+is, the conclusion is designated whenever every premise is designated.
+
+For example, Yes entails Maybe in LP, where Maybe is designated, but not in K3.
+*/
+func Entails(premises []Tribool, conclusion Tribool, logic Logic) bool {
+	for _, p := range premises {
+		if !logic.Designated(p) {
+			return true
+		}
+	}
+	return logic.Designated(conclusion)
+}
+
+// forEachAssignment calls fn with every assignment of states to arity
+// variables, stopping as soon as fn returns false. It reports whether fn
+// returned true for every assignment.
+func forEachAssignment(states []Tribool, arity int, fn func([]Tribool) bool) bool {
+	if arity < 0 || arity > MaxArity {
+		panic(fmt.Sprintf("tribool: arity %d out of range [0, %d]", arity, MaxArity))
+	}
+
+	idx := make([]int, arity)
+	vs := make([]Tribool, arity)
+	for {
+		for i, j := range idx {
+			vs[i] = states[j]
+		}
+		if !fn(vs) {
+			return false
+		}
+
+		// advance to the next assignment like an odometer
+		i := 0
+		for ; i < arity; i++ {
+			idx[i]++
+			if idx[i] < len(states) {
+				break
+			}
+			idx[i] = 0
+		}
+		if i == arity {
+			return true
+		}
+	}
+}
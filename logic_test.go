@@ -0,0 +1,338 @@
+package tribool
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestIsTautology(t *testing.T) {
+	table := []struct {
+		name     string
+		f        func([]Tribool) Tribool
+		arity    int
+		expected bool
+	}{
+		{"a ∨ ¬a", func(v []Tribool) Tribool { return v[0].Or(v[0].Not()) }, 1, false},
+		{"a ⇒ a", func(v []Tribool) Tribool { return v[0].Imply(v[0]) }, 1, false},
+		{"(a ∨ ¬a) ∨ Y", func(v []Tribool) Tribool { return v[0].Or(v[0].Not()).Or(Yes) }, 1, true},
+		{"(a ∧ b) ⇒ a", func(v []Tribool) Tribool { return v[0].And(v[1]).Imply(v[0]) }, 2, false},
+		{"Y", func(v []Tribool) Tribool { return Yes }, 0, true},
+		{"a ∧ ¬a", func(v []Tribool) Tribool { return v[0].And(v[0].Not()) }, 1, false},
+	}
+	for _, test := range table {
+		actual := IsTautology(test.f, test.arity)
+		if actual != test.expected {
+			t.Errorf("IsTautology(%s) => %v instead of the expected %v", test.name, actual, test.expected)
+		}
+	}
+}
+
+func TestIsContradiction(t *testing.T) {
+	table := []struct {
+		name     string
+		f        func([]Tribool) Tribool
+		arity    int
+		expected bool
+	}{
+		{"a ∧ ¬a", func(v []Tribool) Tribool { return v[0].And(v[0].Not()) }, 1, false},
+		{"(a ∧ ¬a) ∧ N", func(v []Tribool) Tribool { return v[0].And(v[0].Not()).And(No) }, 1, true},
+		{"a ⊕ b", func(v []Tribool) Tribool { return v[0].Xor(v[1]) }, 2, false},
+		{"N", func(v []Tribool) Tribool { return No }, 0, true},
+	}
+	for _, test := range table {
+		actual := IsContradiction(test.f, test.arity)
+		if actual != test.expected {
+			t.Errorf("IsContradiction(%s) => %v instead of the expected %v", test.name, actual, test.expected)
+		}
+	}
+}
+
+func TestIsTautology_assignments(t *testing.T) {
+	seen := map[[3]Tribool]bool{}
+	IsTautology(func(v []Tribool) Tribool {
+		seen[[3]Tribool{v[0], v[1], v[2]}] = true
+		return Yes
+	}, 3)
+	if len(seen) != 27 {
+		t.Errorf("IsTautology visited %d assignments instead of the expected 27", len(seen))
+	}
+}
+
+func TestIsTautology_arity(t *testing.T) {
+	for _, arity := range []int{-1, MaxArity + 1} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("IsTautology with arity %d should panic", arity)
+				}
+			}()
+			IsTautology(func(v []Tribool) Tribool { return Yes }, arity)
+		}()
+	}
+}
+
+func TestPossibly_Necessarily(t *testing.T) {
+	table := []struct {
+		name        string
+		f           func([]Tribool) Tribool
+		arity       int
+		possibly    bool
+		necessarily bool
+	}{
+		{"a ∨ ¬a", func(v []Tribool) Tribool { return v[0].Or(v[0].Not()) }, 1, true, true},
+		{"a ∧ ¬a", func(v []Tribool) Tribool { return v[0].And(v[0].Not()) }, 1, false, false},
+		{"a ∧ b", func(v []Tribool) Tribool { return v[0].And(v[1]) }, 2, true, false},
+		{"a ⇒ (a ∨ b)", func(v []Tribool) Tribool { return v[0].Imply(v[0].Or(v[1])) }, 2, true, true},
+		{"?", func(v []Tribool) Tribool { return Maybe }, 0, false, false},
+	}
+	for _, test := range table {
+		if actual := Possibly(test.f, test.arity); actual != test.possibly {
+			t.Errorf("Possibly(%s) => %v instead of the expected %v", test.name, actual, test.possibly)
+		}
+		if actual := Necessarily(test.f, test.arity); actual != test.necessarily {
+			t.Errorf("Necessarily(%s) => %v instead of the expected %v", test.name, actual, test.necessarily)
+		}
+	}
+}
+
+func TestNecessarily_neverMaybe(t *testing.T) {
+	Necessarily(func(v []Tribool) Tribool {
+		for _, a := range v {
+			if a == Maybe {
+				t.Errorf("Necessarily passed Maybe to f")
+			}
+		}
+		return Yes
+	}, 4)
+}
+
+func TestResolutions(t *testing.T) {
+	N, x, Y := No, Maybe, Yes
+	table := []struct {
+		vs       []Tribool
+		expected [][]bool
+	}{
+		{[]Tribool{}, [][]bool{{}}},
+		{[]Tribool{Y, N}, [][]bool{{true, false}}},
+		{[]Tribool{Y, x}, [][]bool{{true, false}, {true, true}}},
+		{[]Tribool{x, N, x}, [][]bool{
+			{false, false, false},
+			{true, false, false},
+			{false, false, true},
+			{true, false, true},
+		}},
+	}
+	for _, test := range table {
+		actual := Resolutions(test.vs)
+		if len(actual) != len(test.expected) {
+			t.Errorf("Resolutions(%v) => %v instead of the expected %v", test.vs, actual, test.expected)
+			continue
+		}
+		for i := range actual {
+			if fmt.Sprint(actual[i]) != fmt.Sprint(test.expected[i]) {
+				t.Errorf("Resolutions(%v) => %v instead of the expected %v", test.vs, actual, test.expected)
+				break
+			}
+		}
+	}
+}
+
+func TestResolutions_tooManyUnknowns(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Resolutions with more than MaxArity unknowns did not panic")
+		}
+	}()
+	vs := make([]Tribool, MaxArity+1)
+	for i := range vs {
+		vs[i] = Maybe
+	}
+	Resolutions(vs)
+}
+
+func TestEntropyBits(t *testing.T) {
+	N, x, Y := No, Maybe, Yes
+	table := []struct {
+		vs       []Tribool
+		expected float64
+	}{
+		{nil, 0},
+		{[]Tribool{Y, N, Y, N}, 0},
+		{[]Tribool{x}, 1},
+		{[]Tribool{Y, x, N, x, x}, 3},
+	}
+	for _, test := range table {
+		if actual := EntropyBits(test.vs); actual != test.expected {
+			t.Errorf("EntropyBits(%v) => %v instead of the expected %v", test.vs, actual, test.expected)
+		}
+		if n := len(Resolutions(test.vs)); float64(n) != math.Exp2(test.expected) {
+			t.Errorf("Resolutions(%v) has %d slices, not 2^EntropyBits", test.vs, n)
+		}
+	}
+
+	for _, a := range values {
+		if a.IsInformative() != (a == Maybe) {
+			t.Errorf("%s.IsInformative() => %v", a, a.IsInformative())
+		}
+	}
+}
+
+func TestTruthTable(t *testing.T) {
+	N, x, Y := No, Maybe, Yes
+	expected := [3][3]Tribool{
+		{N, N, N},
+		{N, x, x},
+		{N, x, Y},
+	}
+	if actual := TruthTable(Tribool.And); actual != expected {
+		t.Errorf("TruthTable(And) => %v instead of the expected %v", actual, expected)
+	}
+
+	expected = [3][3]Tribool{
+		{Y, Y, Y},
+		{x, x, Y},
+		{N, x, Y},
+	}
+	if actual := TruthTable(Tribool.Imply); actual != expected {
+		t.Errorf("TruthTable(Imply) => %v instead of the expected %v", actual, expected)
+	}
+}
+
+func TestFormatTruthTable(t *testing.T) {
+	expected := `a b | op(a, b)
+----+---------
+N N | N
+N ? | N
+N Y | N
+? N | N
+? ? | ?
+? Y | ?
+Y N | N
+Y ? | ?
+Y Y | Y
+`
+	if actual := FormatTruthTable(Tribool.And); actual != expected {
+		t.Errorf("FormatTruthTable(And) =>\n%s\ninstead of the expected\n%s", actual, expected)
+	}
+}
+
+func TestDual(t *testing.T) {
+	table := []struct {
+		name     string
+		op, dual func(a, b Tribool) Tribool
+	}{
+		{"and", Tribool.And, Tribool.Or},
+		{"or", Tribool.Or, Tribool.And},
+		{"nand", Tribool.Nand, Tribool.Nor},
+		{"nor", Tribool.Nor, Tribool.Nand},
+		{"xor", Tribool.Xor, Tribool.Equiv},
+		{"equiv", Tribool.Equiv, Tribool.Xor},
+	}
+	for _, test := range table {
+		actual, expected := TruthTable(Dual(test.op)), TruthTable(test.dual)
+		if actual != expected {
+			t.Errorf("TruthTable(Dual(%s)) => %v instead of the expected %v", test.name, actual, expected)
+		}
+		if again := TruthTable(Dual(Dual(test.op))); again != TruthTable(test.op) {
+			t.Errorf("Dual(Dual(%s)) => %v is not %s", test.name, again, test.name)
+		}
+	}
+}
+
+func TestComplement(t *testing.T) {
+	table := []struct {
+		name           string
+		op, complement func(a, b Tribool) Tribool
+	}{
+		{"and", Tribool.And, Tribool.Nand},
+		{"or", Tribool.Or, Tribool.Nor},
+		{"nand", Tribool.Nand, Tribool.And},
+		{"xor", Tribool.Xor, Tribool.Equiv},
+		{"imply", Tribool.Imply, Tribool.NonImply},
+	}
+	for _, test := range table {
+		actual, expected := TruthTable(Complement(test.op)), TruthTable(test.complement)
+		if actual != expected {
+			t.Errorf("TruthTable(Complement(%s)) => %v instead of the expected %v", test.name, actual, expected)
+		}
+	}
+}
+
+func TestIsCommutative_IsAssociative(t *testing.T) {
+	table := []struct {
+		name                     string
+		op                       func(a, b Tribool) Tribool
+		commutative, associative bool
+	}{
+		{"and", Tribool.And, true, true},
+		{"or", Tribool.Or, true, true},
+		{"xor", Tribool.Xor, true, true},
+		{"equiv", Tribool.Equiv, true, true},
+		{"consensus", Tribool.Consensus, true, true},
+		{"nand", Tribool.Nand, true, false},
+		{"nor", Tribool.Nor, true, false},
+		{"implies", Tribool.Imply, false, false},
+		{"nonimplies", Tribool.NonImply, false, false},
+	}
+	for _, test := range table {
+		if actual := IsCommutative(test.op); actual != test.commutative {
+			t.Errorf("IsCommutative(%s) => %v instead of the expected %v", test.name, actual, test.commutative)
+		}
+		if actual := IsAssociative(test.op); actual != test.associative {
+			t.Errorf("IsAssociative(%s) => %v instead of the expected %v", test.name, actual, test.associative)
+		}
+	}
+}
+
+func TestLogic_Designated(t *testing.T) {
+	table := []struct {
+		logic        Logic
+		name         string
+		no, maybe, y bool
+	}{
+		{K3, "K3", false, false, true},
+		{LP, "LP", false, true, true},
+	}
+	for _, test := range table {
+		if test.logic.Designated(No) != test.no ||
+			test.logic.Designated(Maybe) != test.maybe ||
+			test.logic.Designated(Yes) != test.y {
+			t.Errorf("%s designates the wrong states", test.name)
+		}
+	}
+}
+
+func TestEntails(t *testing.T) {
+	N, x, Y := No, Maybe, Yes
+	table := []struct {
+		premises   []Tribool
+		conclusion Tribool
+		k3, lp     bool
+	}{
+		// valid in LP but not K3
+		{[]Tribool{Y}, x, false, true},
+		{[]Tribool{Y, Y}, x, false, true},
+
+		// valid in K3 but not LP
+		{[]Tribool{x}, N, true, false},
+		{[]Tribool{Y, x}, N, true, false},
+
+		// valid in both
+		{[]Tribool{Y}, Y, true, true},
+		{[]Tribool{N}, N, true, true},
+		{nil, Y, true, true},
+
+		// valid in neither
+		{[]Tribool{Y}, N, false, false},
+		{nil, N, false, false},
+	}
+	for _, test := range table {
+		if actual := Entails(test.premises, test.conclusion, K3); actual != test.k3 {
+			t.Errorf("Entails(%v, %s, K3) => %v instead of the expected %v", test.premises, test.conclusion, actual, test.k3)
+		}
+		if actual := Entails(test.premises, test.conclusion, LP); actual != test.lp {
+			t.Errorf("Entails(%v, %s, LP) => %v instead of the expected %v", test.premises, test.conclusion, actual, test.lp)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package tribool
+
+import (
+	"fmt"
+	"reflect"
+)
+
+var triboolType = reflect.TypeOf(Tribool(0))
+
+/*
+DecodeHookFunc returns a decode hook for github.com/mitchellh/mapstructure, as
+used by Viper, that decodes config values into Tribool fields:
+
+	    source | result
+	-----------+---------------------------------------
+	       nil | Maybe
+	   Tribool | itself
+	    string | FromString
+	      bool | FromBool
+	 int, uint | zero is No, anything else is Yes
+	     float | zero is No, other whole numbers are Yes, fractions are Maybe
+
+Any other source is an error. Values decoded into other types pass through
+unchanged. The nil row only applies when the hook is called directly:
+mapstructure does not call hooks for a nil source, and leaves the field as it
+was, so set Maybe defaults before decoding.
+
+The hook has the signature of mapstructure.DecodeHookFuncType, so this package
+does not import mapstructure:
+
+	viper.Unmarshal(&cfg, viper.DecodeHook(tribool.DecodeHookFunc()))
+*/
+func DecodeHookFunc() func(from, to reflect.Type, data interface{}) (interface{}, error) {
+	return func(from, to reflect.Type, data interface{}) (interface{}, error) {
+		if to != triboolType {
+			return data, nil
+		}
+		switch data := data.(type) {
+		case nil:
+			return maybe, nil
+		case Tribool:
+			return data, nil
+		}
+		v := reflect.ValueOf(data)
+		switch v.Kind() {
+		case reflect.String:
+			return FromString(v.String()), nil
+		case reflect.Bool:
+			return FromBool(v.Bool()), nil
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return FromBool(v.Int() != 0), nil
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			return FromBool(v.Uint() != 0), nil
+		case reflect.Float32, reflect.Float64:
+			return fromNumber(v.Float()), nil
+		}
+		return nil, fmt.Errorf("tribool: cannot decode %s into a Tribool", from)
+	}
+}
@@ -0,0 +1,42 @@
+package tribool
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDecodeHookFunc(t *testing.T) {
+	hook := DecodeHookFunc()
+	table := []struct {
+		data     interface{}
+		expected Tribool
+	}{
+		{nil, Maybe},
+		{Yes, Yes}, {Maybe, Maybe},
+		{"yes", Yes}, {"off", No}, {"", Maybe},
+		{true, Yes}, {false, No},
+		{0, No}, {1, Yes}, {int64(-4), Yes}, {int8(0), No},
+		{uint(0), No}, {uint16(3), Yes},
+		{0.0, No}, {2.0, Yes}, {float32(0.5), Maybe},
+	}
+	for _, test := range table {
+		actual, err := hook(reflect.TypeOf(test.data), triboolType, test.data)
+		if err != nil {
+			t.Errorf("hook(%#v) returned error: %v", test.data, err)
+		} else if actual != test.expected {
+			t.Errorf("hook(%#v) => %#v instead of the expected %s", test.data, actual, test.expected)
+		}
+	}
+
+	for _, data := range []interface{}{[]string{"yes"}, map[string]interface{}{}, struct{}{}} {
+		if actual, err := hook(reflect.TypeOf(data), triboolType, data); err == nil {
+			t.Errorf("hook(%#v) => %#v instead of an error", data, actual)
+		}
+	}
+
+	// other target types pass through
+	actual, err := hook(reflect.TypeOf("yes"), reflect.TypeOf(""), "yes")
+	if err != nil || actual != "yes" {
+		t.Errorf("hook into a string => %#v, %v instead of the unchanged data", actual, err)
+	}
+}
@@ -0,0 +1,610 @@
+package tribool
+
+import (
+	"errors"
+	"log/slog"
+	"math/rand"
+)
+
+/*
+MaybeDefault is a Tribool whose zero value is Maybe.
+
+The zero value of a plain Tribool is No, just like a bool. That is the wrong
+default for a field that should be unknown until it is set, such as a struct
+field that is only filled in by some code paths. Use MaybeDefault for those:
+
+	type Node struct {
+		IsActive tribool.MaybeDefault // Maybe until set
+	}
+
+	n.IsActive = tribool.NewMaybeDefault(tribool.Yes)
+
+MaybeDefault has the same methods as Tribool, which delegate to m.Tribool()
+and return plain Tribools.
+*/
+type MaybeDefault struct {
+	d int // the Tribool minus one, so that the zero value is Maybe
+}
+
+/*
+NewMaybeDefault converts a Tribool to a MaybeDefault. There is only one
+MaybeDefault for each state, so NewMaybeDefault(Maybe) == MaybeDefault{}.
+*/
+func NewMaybeDefault(a Tribool) MaybeDefault {
+	return MaybeDefault{int(a) - 1}
+}
+
+/*
+Tribool converts a MaybeDefault to a Tribool. The zero value is Maybe.
+*/
+func (m MaybeDefault) Tribool() Tribool {
+	return Tribool(m.d + 1)
+}
+
+/*
+String is equivalent to m.Tribool().String()
+*/
+func (m MaybeDefault) String() string {
+	return m.Tribool().String()
+}
+
+/*
+GoString prints the MaybeDefault as a call to NewMaybeDefault, using
+m.Tribool().GoString() for its argument, for use with the %#v format verb.
+*/
+func (m MaybeDefault) GoString() string {
+	return "tribool.NewMaybeDefault(" + m.Tribool().GoString() + ")"
+}
+
+/*
+WithMaybeAsTrue is equivalent to m.Tribool().WithMaybeAsTrue()
+*/
+func (m MaybeDefault) WithMaybeAsTrue() bool {
+	return m.Tribool().WithMaybeAsTrue()
+}
+
+/*
+WithMaybeAsFalse is equivalent to m.Tribool().WithMaybeAsFalse()
+*/
+func (m MaybeDefault) WithMaybeAsFalse() bool {
+	return m.Tribool().WithMaybeAsFalse()
+}
+
+/*
+WithMaybeAs is equivalent to m.Tribool().WithMaybeAs(b)
+*/
+func (m MaybeDefault) WithMaybeAs(b bool) bool {
+	return m.Tribool().WithMaybeAs(b)
+}
+
+/*
+Not is equivalent to m.Tribool().Not()
+*/
+func (m MaybeDefault) Not() Tribool {
+	return m.Tribool().Not()
+}
+
+/*
+And is equivalent to m.Tribool().And(b)
+*/
+func (m MaybeDefault) And(b Tribool) Tribool {
+	return m.Tribool().And(b)
+}
+
+/*
+AndBool is equivalent to m.Tribool().AndBool(b)
+*/
+func (m MaybeDefault) AndBool(b bool) Tribool {
+	return m.Tribool().AndBool(b)
+}
+
+/*
+Or is equivalent to m.Tribool().Or(b)
+*/
+func (m MaybeDefault) Or(b Tribool) Tribool {
+	return m.Tribool().Or(b)
+}
+
+/*
+OrBool is equivalent to m.Tribool().OrBool(b)
+*/
+func (m MaybeDefault) OrBool(b bool) Tribool {
+	return m.Tribool().OrBool(b)
+}
+
+/*
+Nand is equivalent to m.Tribool().Nand(b)
+*/
+func (m MaybeDefault) Nand(b Tribool) Tribool {
+	return m.Tribool().Nand(b)
+}
+
+/*
+NandBool is equivalent to m.Tribool().NandBool(b)
+*/
+func (m MaybeDefault) NandBool(b bool) Tribool {
+	return m.Tribool().NandBool(b)
+}
+
+/*
+Nor is equivalent to m.Tribool().Nor(b)
+*/
+func (m MaybeDefault) Nor(b Tribool) Tribool {
+	return m.Tribool().Nor(b)
+}
+
+/*
+NorBool is equivalent to m.Tribool().NorBool(b)
+*/
+func (m MaybeDefault) NorBool(b bool) Tribool {
+	return m.Tribool().NorBool(b)
+}
+
+/*
+Xor is equivalent to m.Tribool().Xor(b)
+*/
+func (m MaybeDefault) Xor(b Tribool) Tribool {
+	return m.Tribool().Xor(b)
+}
+
+/*
+XorBool is equivalent to m.Tribool().XorBool(b)
+*/
+func (m MaybeDefault) XorBool(b bool) Tribool {
+	return m.Tribool().XorBool(b)
+}
+
+/*
+Imply is equivalent to m.Tribool().Imply(b)
+*/
+func (m MaybeDefault) Imply(b Tribool) Tribool {
+	return m.Tribool().Imply(b)
+}
+
+/*
+ImplyBool is equivalent to m.Tribool().ImplyBool(b)
+*/
+func (m MaybeDefault) ImplyBool(b bool) Tribool {
+	return m.Tribool().ImplyBool(b)
+}
+
+/*
+Equiv is equivalent to m.Tribool().Equiv(b)
+*/
+func (m MaybeDefault) Equiv(b Tribool) Tribool {
+	return m.Tribool().Equiv(b)
+}
+
+/*
+EquivBool is equivalent to m.Tribool().EquivBool(b)
+*/
+func (m MaybeDefault) EquivBool(b bool) Tribool {
+	return m.Tribool().EquivBool(b)
+}
+
+/*
+ImpliedBy is equivalent to m.Tribool().ImpliedBy(b)
+*/
+func (m MaybeDefault) ImpliedBy(b Tribool) Tribool {
+	return m.Tribool().ImpliedBy(b)
+}
+
+/*
+ImpliedByBool is equivalent to m.Tribool().ImpliedByBool(b)
+*/
+func (m MaybeDefault) ImpliedByBool(b bool) Tribool {
+	return m.Tribool().ImpliedByBool(b)
+}
+
+/*
+NonImply is equivalent to m.Tribool().NonImply(b)
+*/
+func (m MaybeDefault) NonImply(b Tribool) Tribool {
+	return m.Tribool().NonImply(b)
+}
+
+/*
+NonImplyBool is equivalent to m.Tribool().NonImplyBool(b)
+*/
+func (m MaybeDefault) NonImplyBool(b bool) Tribool {
+	return m.Tribool().NonImplyBool(b)
+}
+
+/*
+ConverseImply is equivalent to m.Tribool().ConverseImply(b)
+*/
+func (m MaybeDefault) ConverseImply(b Tribool) Tribool {
+	return m.Tribool().ConverseImply(b)
+}
+
+/*
+ConverseImplyBool is equivalent to m.Tribool().ConverseImplyBool(b)
+*/
+func (m MaybeDefault) ConverseImplyBool(b bool) Tribool {
+	return m.Tribool().ConverseImplyBool(b)
+}
+
+/*
+ConverseNonImply is equivalent to m.Tribool().ConverseNonImply(b)
+*/
+func (m MaybeDefault) ConverseNonImply(b Tribool) Tribool {
+	return m.Tribool().ConverseNonImply(b)
+}
+
+/*
+ConverseNonImplyBool is equivalent to m.Tribool().ConverseNonImplyBool(b)
+*/
+func (m MaybeDefault) ConverseNonImplyBool(b bool) Tribool {
+	return m.Tribool().ConverseNonImplyBool(b)
+}
+
+/*
+AndNot is equivalent to m.Tribool().AndNot(b)
+*/
+func (m MaybeDefault) AndNot(b Tribool) Tribool {
+	return m.Tribool().AndNot(b)
+}
+
+/*
+AndNotBool is equivalent to m.Tribool().AndNotBool(b)
+*/
+func (m MaybeDefault) AndNotBool(b bool) Tribool {
+	return m.Tribool().AndNotBool(b)
+}
+
+/*
+OrNot is equivalent to m.Tribool().OrNot(b)
+*/
+func (m MaybeDefault) OrNot(b Tribool) Tribool {
+	return m.Tribool().OrNot(b)
+}
+
+/*
+OrNotBool is equivalent to m.Tribool().OrNotBool(b)
+*/
+func (m MaybeDefault) OrNotBool(b bool) Tribool {
+	return m.Tribool().OrNotBool(b)
+}
+
+/*
+Stroke is equivalent to m.Tribool().Stroke(b)
+*/
+func (m MaybeDefault) Stroke(b Tribool) Tribool {
+	return m.Tribool().Stroke(b)
+}
+
+/*
+Arrow is equivalent to m.Tribool().Arrow(b)
+*/
+func (m MaybeDefault) Arrow(b Tribool) Tribool {
+	return m.Tribool().Arrow(b)
+}
+
+/*
+Consensus is equivalent to m.Tribool().Consensus(b)
+*/
+func (m MaybeDefault) Consensus(b Tribool) Tribool {
+	return m.Tribool().Consensus(b)
+}
+
+/*
+Merge is equivalent to m.Tribool().Merge(b)
+*/
+func (m MaybeDefault) Merge(b Tribool) (merged Tribool, conflict bool) {
+	return m.Tribool().Merge(b)
+}
+
+/*
+SqlAnd is equivalent to m.Tribool().SqlAnd(b)
+*/
+func (m MaybeDefault) SqlAnd(b Tribool) Tribool {
+	return m.Tribool().SqlAnd(b)
+}
+
+/*
+SqlOr is equivalent to m.Tribool().SqlOr(b)
+*/
+func (m MaybeDefault) SqlOr(b Tribool) Tribool {
+	return m.Tribool().SqlOr(b)
+}
+
+/*
+SqlEqual is equivalent to m.Tribool().SqlEqual(b)
+*/
+func (m MaybeDefault) SqlEqual(b Tribool) Tribool {
+	return m.Tribool().SqlEqual(b)
+}
+
+/*
+Same is equivalent to m.Tribool().Same(b)
+*/
+func (m MaybeDefault) Same(b Tribool) bool {
+	return m.Tribool().Same(b)
+}
+
+/*
+Compare is equivalent to m.Tribool().Compare(b)
+*/
+func (m MaybeDefault) Compare(b Tribool) int {
+	return m.Tribool().Compare(b)
+}
+
+/*
+Less is equivalent to m.Tribool().Less(b)
+*/
+func (m MaybeDefault) Less(b Tribool) bool {
+	return m.Tribool().Less(b)
+}
+
+/*
+IsMaybe is equivalent to m.Tribool().IsMaybe()
+*/
+func (m MaybeDefault) IsMaybe() bool {
+	return m.Tribool().IsMaybe()
+}
+
+/*
+IsNull is equivalent to m.Tribool().IsNull()
+*/
+func (m MaybeDefault) IsNull() bool {
+	return m.Tribool().IsNull()
+}
+
+/*
+IsValid is equivalent to m.Tribool().IsValid()
+*/
+func (m MaybeDefault) IsValid() bool {
+	return m.Tribool().IsValid()
+}
+
+/*
+IsInformative is equivalent to m.Tribool().IsInformative()
+*/
+func (m MaybeDefault) IsInformative() bool {
+	return m.Tribool().IsInformative()
+}
+
+/*
+Canonicalize is equivalent to m.Tribool().Canonicalize()
+*/
+func (m MaybeDefault) Canonicalize() Tribool {
+	return m.Tribool().Canonicalize()
+}
+
+/*
+Upgrade is equivalent to m.Tribool().Upgrade()
+*/
+func (m MaybeDefault) Upgrade() Tribool {
+	return m.Tribool().Upgrade()
+}
+
+/*
+Downgrade is equivalent to m.Tribool().Downgrade()
+*/
+func (m MaybeDefault) Downgrade() Tribool {
+	return m.Tribool().Downgrade()
+}
+
+/*
+Weaken is equivalent to m.Tribool().Weaken()
+*/
+func (m MaybeDefault) Weaken() Tribool {
+	return m.Tribool().Weaken()
+}
+
+/*
+Strengthen is equivalent to m.Tribool().Strengthen(toward)
+*/
+func (m MaybeDefault) Strengthen(toward Tribool) Tribool {
+	return m.Tribool().Strengthen(toward)
+}
+
+/*
+Delta is equivalent to m.Tribool().Delta(prev)
+*/
+func (m MaybeDefault) Delta(prev Tribool) Change {
+	return m.Tribool().Delta(prev)
+}
+
+/*
+When is equivalent to m.Tribool().When()
+*/
+func (m MaybeDefault) When() Branch {
+	return m.Tribool().When()
+}
+
+/*
+KnownValue is equivalent to m.Tribool().KnownValue()
+*/
+func (m MaybeDefault) KnownValue() (known, value bool) {
+	return m.Tribool().KnownValue()
+}
+
+/*
+MustBool is equivalent to m.Tribool().MustBool()
+*/
+func (m MaybeDefault) MustBool() (bool, error) {
+	return m.Tribool().MustBool()
+}
+
+/*
+MustBoolStrict is equivalent to m.Tribool().MustBoolStrict()
+*/
+func (m MaybeDefault) MustBoolStrict() bool {
+	return m.Tribool().MustBoolStrict()
+}
+
+/*
+Probability is equivalent to m.Tribool().Probability()
+*/
+func (m MaybeDefault) Probability() float64 {
+	return m.Tribool().Probability()
+}
+
+/*
+Resolve is equivalent to m.Tribool().Resolve(r, pTrue)
+*/
+func (m MaybeDefault) Resolve(r *rand.Rand, pTrue float64) bool {
+	return m.Tribool().Resolve(r, pTrue)
+}
+
+/*
+AsRef is equivalent to m.Tribool().AsRef()
+*/
+func (m MaybeDefault) AsRef() any {
+	return m.Tribool().AsRef()
+}
+
+/*
+ToProtoEnum is equivalent to m.Tribool().ToProtoEnum()
+*/
+func (m MaybeDefault) ToProtoEnum() int32 {
+	return m.Tribool().ToProtoEnum()
+}
+
+/*
+Title is equivalent to m.Tribool().Title()
+*/
+func (m MaybeDefault) Title() string {
+	return m.Tribool().Title()
+}
+
+/*
+Symbol is equivalent to m.Tribool().Symbol()
+*/
+func (m MaybeDefault) Symbol() string {
+	return m.Tribool().Symbol()
+}
+
+/*
+Glyph is equivalent to m.Tribool().Glyph()
+*/
+func (m MaybeDefault) Glyph() rune {
+	return m.Tribool().Glyph()
+}
+
+/*
+TrueFalse is equivalent to m.Tribool().TrueFalse()
+*/
+func (m MaybeDefault) TrueFalse() string {
+	return m.Tribool().TrueFalse()
+}
+
+/*
+CSVField is equivalent to m.Tribool().CSVField()
+*/
+func (m MaybeDefault) CSVField() string {
+	return m.Tribool().CSVField()
+}
+
+/*
+AppendSymbol is equivalent to m.Tribool().AppendSymbol(dst)
+*/
+func (m MaybeDefault) AppendSymbol(dst []byte) []byte {
+	return m.Tribool().AppendSymbol(dst)
+}
+
+/*
+MarshalText is equivalent to m.Tribool().MarshalText(), so a MaybeDefault can
+be a key of a map encoded as JSON.
+*/
+func (m MaybeDefault) MarshalText() ([]byte, error) {
+	return m.Tribool().MarshalText()
+}
+
+/*
+AppendText is equivalent to m.Tribool().AppendText(dst)
+*/
+func (m MaybeDefault) AppendText(dst []byte) ([]byte, error) {
+	return m.Tribool().AppendText(dst)
+}
+
+/*
+UnmarshalText converts text to a MaybeDefault using FromBytes.
+*/
+func (m *MaybeDefault) UnmarshalText(text []byte) error {
+	if m == nil {
+		return errors.New("tribool.MaybeDefault: UnmarshalText on nil pointer")
+	}
+	*m = NewMaybeDefault(FromBytes(text))
+	return nil
+}
+
+/*
+Set parses s with FromString and stores the result. Together with String and
+Type, this lets a *MaybeDefault be used as a flag.Value or a pflag.Value, whose
+value is Maybe until the flag is given.
+*/
+func (m *MaybeDefault) Set(s string) error {
+	*m = NewMaybeDefault(FromString(s))
+	return nil
+}
+
+/*
+Type names the flag type, as required by pflag.Value.
+*/
+func (m *MaybeDefault) Type() string {
+	return "tribool"
+}
+
+// MarshalJSON is equivalent to m.Tribool().MarshalJSON()
+func (m MaybeDefault) MarshalJSON() ([]byte, error) {
+	return m.Tribool().MarshalJSON()
+}
+
+// UnmarshalJSON unmarshals the same input as Tribool.UnmarshalJSON.
+func (m *MaybeDefault) UnmarshalJSON(data []byte) error {
+	if m == nil {
+		return errors.New("tribool.MaybeDefault: UnmarshalJSON on nil pointer")
+	}
+	var a Tribool
+	if err := a.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	*m = NewMaybeDefault(a)
+	return nil
+}
+
+/*
+MarshalBinary is equivalent to m.Tribool().MarshalBinary()
+*/
+func (m MaybeDefault) MarshalBinary() ([]byte, error) {
+	return m.Tribool().MarshalBinary()
+}
+
+/*
+UnmarshalBinary unmarshals the same input as Tribool.UnmarshalBinary.
+*/
+func (m *MaybeDefault) UnmarshalBinary(data []byte) error {
+	if m == nil {
+		return errors.New("tribool.MaybeDefault: UnmarshalBinary on nil pointer")
+	}
+	var a Tribool
+	if err := a.UnmarshalBinary(data); err != nil {
+		return err
+	}
+	*m = NewMaybeDefault(a)
+	return nil
+}
+
+/*
+Scan scans the same values as Tribool.Scan.
+*/
+func (m *MaybeDefault) Scan(src interface{}) error {
+	if m == nil {
+		return errors.New("tribool.MaybeDefault: Scan on nil pointer")
+	}
+	var a Tribool
+	if err := a.Scan(src); err != nil {
+		return err
+	}
+	*m = NewMaybeDefault(a)
+	return nil
+}
+
+/*
+LogValue is equivalent to m.Tribool().LogValue()
+*/
+func (m MaybeDefault) LogValue() slog.Value {
+	return m.Tribool().LogValue()
+}
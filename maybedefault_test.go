@@ -0,0 +1,218 @@
+package tribool
+
+import (
+	"encoding/json"
+	"flag"
+	"math/rand"
+	"testing"
+)
+
+func TestMaybeDefault_zero(t *testing.T) {
+	var m MaybeDefault
+	if actual := m.Tribool(); actual != Maybe {
+		t.Errorf("zero MaybeDefault => %s instead of the expected maybe", actual)
+	}
+	if actual := m.String(); actual != "maybe" {
+		t.Errorf("zero MaybeDefault.String() => %s instead of the expected maybe", actual)
+	}
+	for _, b := range values {
+		if actual := m.And(b); actual != Maybe.And(b) {
+			t.Errorf("zero MaybeDefault.And(%s) => %s instead of the expected %s", b, actual, Maybe.And(b))
+		}
+		if actual := m.Or(b); actual != Maybe.Or(b) {
+			t.Errorf("zero MaybeDefault.Or(%s) => %s instead of the expected %s", b, actual, Maybe.Or(b))
+		}
+	}
+
+	// there is one representation of maybe
+	if NewMaybeDefault(Maybe) != m {
+		t.Errorf("NewMaybeDefault(maybe) != MaybeDefault{}")
+	}
+	counts := map[MaybeDefault]int{}
+	counts[m]++
+	counts[NewMaybeDefault(Maybe)]++
+	if len(counts) != 1 || counts[m] != 2 {
+		t.Errorf("zero and NewMaybeDefault(maybe) are different map keys: %v", counts)
+	}
+	for _, a := range values {
+		for _, b := range values {
+			if (NewMaybeDefault(a) == NewMaybeDefault(b)) != (a == b) {
+				t.Errorf("NewMaybeDefault(%s) == NewMaybeDefault(%s) is %v", a, b, a != b)
+			}
+		}
+	}
+
+	// the plain Tribool zero value is No
+	var a Tribool
+	if a != No {
+		t.Errorf("zero Tribool => %s instead of the expected no", a)
+	}
+}
+
+func TestMaybeDefault_delegates(t *testing.T) {
+	for _, a := range values {
+		m := NewMaybeDefault(a)
+		if m.Tribool() != a {
+			t.Errorf("NewMaybeDefault(%s).Tribool() => %s", a, m.Tribool())
+		}
+		if expected := "tribool.NewMaybeDefault(" + a.GoString() + ")"; m.GoString() != expected {
+			t.Errorf("NewMaybeDefault(%s).GoString() => %s instead of the expected %s", a, m.GoString(), expected)
+		}
+		text, err := m.MarshalText()
+		if expected, _ := a.MarshalText(); err != nil || string(text) != string(expected) {
+			t.Errorf("NewMaybeDefault(%s).MarshalText() => %s, %v instead of the expected %s", a, text, err, expected)
+		}
+		var back MaybeDefault
+		if err := back.UnmarshalText(text); err != nil || back != m {
+			t.Errorf("UnmarshalText(%s) => %s, %v instead of the expected %s", text, back, err, a)
+		}
+		if m.String() != a.String() || m.Not() != a.Not() ||
+			m.WithMaybeAsTrue() != a.WithMaybeAsTrue() ||
+			m.WithMaybeAsFalse() != a.WithMaybeAsFalse() ||
+			m.WithMaybeAs(true) != a.WithMaybeAs(true) ||
+			m.IsMaybe() != a.IsMaybe() || m.IsNull() != a.IsNull() ||
+			m.IsValid() != a.IsValid() || m.IsInformative() != a.IsInformative() ||
+			m.Canonicalize() != a.Canonicalize() ||
+			m.Upgrade() != a.Upgrade() || m.Downgrade() != a.Downgrade() ||
+			m.Weaken() != a.Weaken() || m.When() != a.When() ||
+			m.Probability() != a.Probability() || m.AsRef() != a.AsRef() ||
+			m.ToProtoEnum() != a.ToProtoEnum() ||
+			m.Title() != a.Title() || m.Symbol() != a.Symbol() ||
+			m.Glyph() != a.Glyph() || m.TrueFalse() != a.TrueFalse() ||
+			m.CSVField() != a.CSVField() ||
+			string(m.AppendSymbol(nil)) != string(a.AppendSymbol(nil)) ||
+			!m.LogValue().Equal(a.LogValue()) {
+			t.Errorf("NewMaybeDefault(%s) unary methods do not match", a)
+		}
+		mKnown, mValue := m.KnownValue()
+		aKnown, aValue := a.KnownValue()
+		if mKnown != aKnown || mValue != aValue {
+			t.Errorf("NewMaybeDefault(%s).KnownValue() => %v, %v instead of the expected %v, %v", a, mKnown, mValue, aKnown, aValue)
+		}
+		mBool, mErr := m.MustBool()
+		aBool, aErr := a.MustBool()
+		if mBool != aBool || (mErr == nil) != (aErr == nil) {
+			t.Errorf("NewMaybeDefault(%s).MustBool() => %v, %v instead of the expected %v, %v", a, mBool, mErr, aBool, aErr)
+		}
+		if a != Maybe && m.MustBoolStrict() != a.MustBoolStrict() {
+			t.Errorf("NewMaybeDefault(%s).MustBoolStrict() => %v", a, m.MustBoolStrict())
+		}
+		if mr, ar := m.Resolve(rand.New(rand.NewSource(1)), 0.5), a.Resolve(rand.New(rand.NewSource(1)), 0.5); mr != ar {
+			t.Errorf("NewMaybeDefault(%s).Resolve() => %v instead of the expected %v", a, mr, ar)
+		}
+		appended, err := m.AppendText([]byte("x"))
+		if expected, _ := a.AppendText([]byte("x")); err != nil || string(appended) != string(expected) {
+			t.Errorf("NewMaybeDefault(%s).AppendText() => %s, %v instead of the expected %s", a, appended, err, expected)
+		}
+		data, err := m.MarshalBinary()
+		if expected, _ := a.MarshalBinary(); err != nil || string(data) != string(expected) {
+			t.Errorf("NewMaybeDefault(%s).MarshalBinary() => %v, %v instead of the expected %v", a, data, err, expected)
+		}
+		back = MaybeDefault{}
+		if err := back.UnmarshalBinary(data); err != nil || back != m {
+			t.Errorf("UnmarshalBinary(%v) => %s, %v instead of the expected %s", data, back, err, a)
+		}
+		back = MaybeDefault{}
+		if err := back.Scan(a.String()); err != nil || back != m {
+			t.Errorf("Scan(%q) => %s, %v instead of the expected %s", a.String(), back, err, a)
+		}
+		for _, b := range values {
+			if m.And(b) != a.And(b) || m.Or(b) != a.Or(b) ||
+				m.Nand(b) != a.Nand(b) || m.Nor(b) != a.Nor(b) ||
+				m.Xor(b) != a.Xor(b) || m.Imply(b) != a.Imply(b) ||
+				m.Equiv(b) != a.Equiv(b) || m.ImpliedBy(b) != a.ImpliedBy(b) ||
+				m.NonImply(b) != a.NonImply(b) || m.ConverseImply(b) != a.ConverseImply(b) ||
+				m.ConverseNonImply(b) != a.ConverseNonImply(b) ||
+				m.Same(b) != a.Same(b) || m.Compare(b) != a.Compare(b) || m.Less(b) != a.Less(b) ||
+				m.AndNot(b) != a.AndNot(b) || m.OrNot(b) != a.OrNot(b) ||
+				m.Stroke(b) != a.Stroke(b) || m.Arrow(b) != a.Arrow(b) ||
+				m.Consensus(b) != a.Consensus(b) ||
+				m.SqlAnd(b) != a.SqlAnd(b) || m.SqlOr(b) != a.SqlOr(b) ||
+				m.SqlEqual(b) != a.SqlEqual(b) ||
+				m.Strengthen(b) != a.Strengthen(b) || m.Delta(b) != a.Delta(b) {
+				t.Errorf("NewMaybeDefault(%s) binary methods with %s do not match", a, b)
+			}
+			mMerged, mConflict := m.Merge(b)
+			aMerged, aConflict := a.Merge(b)
+			if mMerged != aMerged || mConflict != aConflict {
+				t.Errorf("NewMaybeDefault(%s).Merge(%s) => %s, %v instead of the expected %s, %v", a, b, mMerged, mConflict, aMerged, aConflict)
+			}
+		}
+		for _, b := range []bool{false, true} {
+			if m.AndBool(b) != a.AndBool(b) || m.OrBool(b) != a.OrBool(b) ||
+				m.NandBool(b) != a.NandBool(b) || m.NorBool(b) != a.NorBool(b) ||
+				m.XorBool(b) != a.XorBool(b) || m.ImplyBool(b) != a.ImplyBool(b) ||
+				m.EquivBool(b) != a.EquivBool(b) || m.ImpliedByBool(b) != a.ImpliedByBool(b) ||
+				m.NonImplyBool(b) != a.NonImplyBool(b) ||
+				m.ConverseImplyBool(b) != a.ConverseImplyBool(b) ||
+				m.ConverseNonImplyBool(b) != a.ConverseNonImplyBool(b) ||
+				m.AndNotBool(b) != a.AndNotBool(b) || m.OrNotBool(b) != a.OrNotBool(b) {
+				t.Errorf("NewMaybeDefault(%s) bool methods with %v do not match", a, b)
+			}
+		}
+	}
+}
+
+func TestMaybeDefault_JSON(t *testing.T) {
+	type config struct {
+		Feature MaybeDefault `json:"feature"`
+	}
+
+	var c config
+	if err := json.Unmarshal([]byte(`{}`), &c); err != nil {
+		t.Fatalf("Unmarshalling {} returned error: %v", err)
+	}
+	if c.Feature.Tribool() != Maybe {
+		t.Errorf("missing field => %s instead of the expected maybe", c.Feature)
+	}
+
+	for _, a := range values {
+		jsonBytes, err := json.Marshal(config{NewMaybeDefault(a)})
+		if err != nil {
+			t.Errorf("Marshalling %s returned error: %v", a, err)
+			continue
+		}
+		var c config
+		if err := json.Unmarshal(jsonBytes, &c); err != nil {
+			t.Errorf("Unmarshalling %s returned error: %v", jsonBytes, err)
+		} else if c.Feature.Tribool() != a {
+			t.Errorf("%s => %s => %s instead of the expected %s", a, jsonBytes, c.Feature, a)
+		}
+	}
+}
+
+func TestMaybeDefault_mapKeyJSON(t *testing.T) {
+	tally := map[MaybeDefault]int{NewMaybeDefault(Yes): 3, {}: 2, NewMaybeDefault(No): 1}
+	jsonBytes, err := json.Marshal(tally)
+	if err != nil {
+		t.Fatalf("Marshalling %v returned error: %v", tally, err)
+	}
+	if expected := `{"maybe":2,"no":1,"yes":3}`; string(jsonBytes) != expected {
+		t.Errorf("json.Marshal(%v) => %s instead of the expected %s", tally, jsonBytes, expected)
+	}
+	var actual map[MaybeDefault]int
+	if err := json.Unmarshal(jsonBytes, &actual); err != nil {
+		t.Fatalf("Unmarshalling %s returned error: %v", jsonBytes, err)
+	}
+	for k, v := range tally {
+		if actual[k] != v {
+			t.Errorf("json.Unmarshal(%s)[%s] => %d instead of the expected %d", jsonBytes, k, actual[k], v)
+		}
+	}
+}
+
+func TestMaybeDefault_flag(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	var unset, set MaybeDefault
+	fs.Var(&unset, "unset", "")
+	fs.Var(&set, "set", "")
+	if err := fs.Parse([]string{"-set=off"}); err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	if unset.Tribool() != Maybe || set.Tribool() != No {
+		t.Errorf("flags => unset=%s, set=%s instead of the expected maybe, no", unset, set)
+	}
+	if actual := set.Type(); actual != "tribool" {
+		t.Errorf("Type() => %s instead of the expected tribool", actual)
+	}
+}
@@ -0,0 +1,149 @@
+package tribool
+
+import (
+	"strconv"
+	"strings"
+)
+
+/*
+ParseBoolCompat parses s with the same rules as strconv.ParseBool, for code
+migrating from it. It accepts exactly
+
+	1, t, T, TRUE, true, True     => Yes
+	0, f, F, FALSE, false, False  => No
+
+and the empty string as Maybe. Anything else returns Maybe and a
+*strconv.NumError wrapping strconv.ErrSyntax.
+
+This is stricter than FromString, which accepts more words in any case and
+never fails.
+*/
+func ParseBoolCompat(s string) (Tribool, error) {
+	if s == "" {
+		return maybe, nil
+	}
+	b, err := strconv.ParseBool(s)
+	if err != nil {
+		return maybe, &strconv.NumError{Func: "ParseBoolCompat", Num: s, Err: strconv.ErrSyntax}
+	}
+	return FromBool(b), nil
+}
+
+/*
+Parser converts strings to Tribools using its own lists of words, such as the
+words of another language. Words are compared case-insensitively; anything not
+in either list is Maybe.
+
+	p := tribool.Parser{
+		True:  []string{"oui", "vrai"},
+		False: []string{"non", "faux"},
+	}
+	flag := p.Parse("Oui") // Yes
+*/
+type Parser struct {
+	True    []string      // words parsed as Yes
+	False   []string      // words parsed as No
+	Numeric NumericPolicy // how decimal integers not in either list are parsed
+}
+
+/*
+NumericPolicy sets how a Parser treats decimal integers, such as counts where 0
+is false and any positive number is true:
+
+	p := tribool.Parser{
+		Numeric: tribool.NumericPolicy{ZeroFalse: true, PositiveTrue: true, NegativeMaybe: true},
+	}
+	p.Parse("42") // Yes
+	p.Parse("-3") // Maybe
+
+An integer is an optional sign followed by decimal digits, of any length. The
+zero value parses every integer as Maybe.
+*/
+type NumericPolicy struct {
+	ZeroFalse     bool // zero is No, rather than Maybe
+	PositiveTrue  bool // positive integers are Yes, rather than Maybe
+	NegativeMaybe bool // negative integers are Maybe, rather than parsed like positive ones
+}
+
+/*
+Parse converts s to a Tribool: Yes if it is one of p.True, No if it is one of
+p.False, as set by p.Numeric if it is an integer, and Maybe otherwise.
+*/
+func (p Parser) Parse(s string) Tribool {
+	for _, word := range p.True {
+		if strings.EqualFold(s, word) {
+			return yes
+		}
+	}
+	for _, word := range p.False {
+		if strings.EqualFold(s, word) {
+			return no
+		}
+	}
+	return p.Numeric.parse(s)
+}
+
+func (np NumericPolicy) parse(s string) Tribool {
+	sign := 1
+	if len(s) > 0 && (s[0] == '+' || s[0] == '-') {
+		if s[0] == '-' {
+			sign = -1
+		}
+		s = s[1:]
+	}
+	if s == "" {
+		return maybe
+	}
+	zero := true
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] < '0' || s[i] > '9':
+			return maybe
+		case s[i] != '0':
+			zero = false
+		}
+	}
+
+	switch {
+	case zero && np.ZeroFalse:
+		return no
+	case zero, sign < 0 && np.NegativeMaybe:
+		return maybe
+	case np.PositiveTrue:
+		return yes
+	}
+	return maybe
+}
+
+/*
+FrenchParser returns a Parser for French: oui, vrai, and 1 are Yes; non, faux,
+and 0 are No.
+*/
+func FrenchParser() Parser {
+	return Parser{
+		True:  []string{"oui", "vrai", "1"},
+		False: []string{"non", "faux", "0"},
+	}
+}
+
+/*
+GermanParser returns a Parser for German: ja, wahr, an, ein, and 1 are Yes;
+nein, falsch, aus, and 0 are No.
+*/
+func GermanParser() Parser {
+	return Parser{
+		True:  []string{"ja", "wahr", "an", "ein", "1"},
+		False: []string{"nein", "falsch", "aus", "0"},
+	}
+}
+
+/*
+SpanishParser returns a Parser for Spanish: sí, si, verdadero, and 1 are Yes;
+no, falso, and 0 are No.
+*/
+func SpanishParser() Parser {
+	return Parser{
+		True:  []string{"sí", "si", "verdadero", "1"},
+		False: []string{"no", "falso", "0"},
+	}
+}
@@ -0,0 +1,159 @@
+package tribool
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestParseBoolCompat(t *testing.T) {
+	table := []struct {
+		raw      string
+		expected Tribool
+	}{
+		{"1", Yes}, {"t", Yes}, {"T", Yes}, {"TRUE", Yes}, {"true", Yes}, {"True", Yes},
+		{"0", No}, {"f", No}, {"F", No}, {"FALSE", No}, {"false", No}, {"False", No},
+		{"", Maybe},
+	}
+	for _, test := range table {
+		actual, err := ParseBoolCompat(test.raw)
+		if err != nil {
+			t.Errorf("ParseBoolCompat(%q) returned error: %v", test.raw, err)
+		} else if actual != test.expected {
+			t.Errorf("ParseBoolCompat(%q) => %s instead of the expected %s", test.raw, actual, test.expected)
+		}
+
+		// the accepted set matches strconv.ParseBool
+		if test.raw != "" {
+			b, err := strconv.ParseBool(test.raw)
+			if err != nil || FromBool(b) != actual {
+				t.Errorf("strconv.ParseBool(%q) => %v, %v but ParseBoolCompat => %s", test.raw, b, err, actual)
+			}
+		}
+	}
+}
+
+func TestParseBoolCompat_rejects(t *testing.T) {
+	table := []string{
+		"yes", "no", "y", "n", "on", "off", "maybe",
+		"tRUE", "fALSE", "TrUe", " true", "true ", "2", "-1", "x",
+	}
+	for _, raw := range table {
+		actual, err := ParseBoolCompat(raw)
+		if err == nil {
+			t.Errorf("ParseBoolCompat(%q) => %s instead of an error", raw, actual)
+			continue
+		}
+		if actual != Maybe {
+			t.Errorf("ParseBoolCompat(%q) => %s with an error instead of the expected maybe", raw, actual)
+		}
+		if !errors.Is(err, strconv.ErrSyntax) {
+			t.Errorf("ParseBoolCompat(%q) error %v does not wrap strconv.ErrSyntax", raw, err)
+		}
+	}
+}
+
+func TestParser(t *testing.T) {
+	p := Parser{True: []string{"affirmative"}, False: []string{"negative"}}
+	table := []struct {
+		raw      string
+		expected Tribool
+	}{
+		{"affirmative", Yes}, {"AFFIRMATIVE", Yes},
+		{"negative", No}, {"Negative", No},
+		{"yes", Maybe}, {"", Maybe}, {"affirm", Maybe},
+	}
+	for _, test := range table {
+		if actual := p.Parse(test.raw); actual != test.expected {
+			t.Errorf("Parse(%q) => %s instead of the expected %s", test.raw, actual, test.expected)
+		}
+	}
+
+	if actual := (Parser{}).Parse("true"); actual != Maybe {
+		t.Errorf("empty Parser Parse(true) => %s instead of the expected maybe", actual)
+	}
+}
+
+func TestParser_presets(t *testing.T) {
+	table := []struct {
+		name   string
+		parser Parser
+		yes    []string
+		no     []string
+	}{
+		{"french", FrenchParser(),
+			[]string{"oui", "OUI", "Oui", "vrai", "VRAI", "1"},
+			[]string{"non", "NON", "Non", "faux", "FAUX", "0"}},
+		{"german", GermanParser(),
+			[]string{"ja", "JA", "Ja", "wahr", "WAHR", "an", "ein", "1"},
+			[]string{"nein", "NEIN", "Nein", "falsch", "FALSCH", "aus", "0"}},
+		{"spanish", SpanishParser(),
+			[]string{"sí", "SÍ", "Sí", "si", "SI", "verdadero", "VERDADERO", "1"},
+			[]string{"no", "NO", "No", "falso", "FALSO", "0"}},
+	}
+	unknown := []string{"", "maybe", "yes", "peut-être", "vielleicht", "quizás", "x"}
+	for _, test := range table {
+		for _, raw := range test.yes {
+			if actual := test.parser.Parse(raw); actual != Yes {
+				t.Errorf("%s Parse(%q) => %s instead of the expected yes", test.name, raw, actual)
+			}
+		}
+		for _, raw := range test.no {
+			if actual := test.parser.Parse(raw); actual != No {
+				t.Errorf("%s Parse(%q) => %s instead of the expected no", test.name, raw, actual)
+			}
+		}
+		for _, raw := range unknown {
+			if actual := test.parser.Parse(raw); actual != Maybe {
+				t.Errorf("%s Parse(%q) => %s instead of the expected maybe", test.name, raw, actual)
+			}
+		}
+	}
+}
+
+func TestParser_Numeric(t *testing.T) {
+	counts := Parser{
+		True:    []string{"all"},
+		False:   []string{"none"},
+		Numeric: NumericPolicy{ZeroFalse: true, PositiveTrue: true, NegativeMaybe: true},
+	}
+	nonzero := Parser{Numeric: NumericPolicy{ZeroFalse: true, PositiveTrue: true}}
+	table := []struct {
+		raw                   string
+		counts, nonzero, none Tribool
+	}{
+		{"42", Yes, Yes, Maybe},
+		{"+7", Yes, Yes, Maybe},
+		{"1", Yes, Yes, Maybe},
+		{"123456789012345678901234567890", Yes, Yes, Maybe},
+		{"-3", Maybe, Yes, Maybe},
+		{"0", No, No, Maybe},
+		{"000", No, No, Maybe},
+		{"-0", No, No, Maybe},
+		{"abc", Maybe, Maybe, Maybe},
+		{"", Maybe, Maybe, Maybe},
+		{"-", Maybe, Maybe, Maybe},
+		{"1.5", Maybe, Maybe, Maybe},
+		{"4x", Maybe, Maybe, Maybe},
+		{" 4", Maybe, Maybe, Maybe},
+		{"all", Yes, Maybe, Maybe},
+		{"none", No, Maybe, Maybe},
+	}
+	for _, test := range table {
+		if actual := counts.Parse(test.raw); actual != test.counts {
+			t.Errorf("counts Parse(%q) => %s instead of the expected %s", test.raw, actual, test.counts)
+		}
+		if actual := nonzero.Parse(test.raw); actual != test.nonzero {
+			t.Errorf("nonzero Parse(%q) => %s instead of the expected %s", test.raw, actual, test.nonzero)
+		}
+		if actual := (Parser{}).Parse(test.raw); actual != test.none {
+			t.Errorf("empty Parser Parse(%q) => %s instead of the expected %s", test.raw, actual, test.none)
+		}
+	}
+
+	// the word lists take precedence over the policy
+	p := Parser{True: []string{"0"}, Numeric: counts.Numeric}
+	if actual := p.Parse("0"); actual != Yes {
+		t.Errorf("Parse(0) with 0 as a true word => %s instead of the expected yes", actual)
+	}
+}
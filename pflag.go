@@ -0,0 +1,24 @@
+//go:build pflag
+
+package tribool
+
+import "github.com/spf13/pflag"
+
+/*
+TriboolVar defines a Tribool flag on fs with the given name, default value, and
+usage string. The flag is stored in p.
+
+Like a bool flag, the flag may be given without a value, as in --name, which
+sets it to Yes. Any value accepted by FromString may be given explicitly, as in
+--name=maybe.
+
+This file is only built with the pflag build tag, so that the pflag dependency
+stays optional:
+
+	go build -tags pflag
+*/
+func TriboolVar(fs *pflag.FlagSet, p *Tribool, name string, value Tribool, usage string) {
+	*p = value
+	fs.Var(p, name, usage)
+	fs.Lookup(name).NoOptDefVal = "true"
+}
@@ -0,0 +1,48 @@
+//go:build pflag
+
+package tribool
+
+import (
+	"testing"
+
+	"github.com/spf13/pflag"
+)
+
+func TestTriboolVar(t *testing.T) {
+	table := []struct {
+		args     []string
+		expected Tribool
+	}{
+		{[]string{}, No},
+		{[]string{"--feature"}, Yes},
+		{[]string{"--feature=no"}, No},
+		{[]string{"--feature=maybe"}, Maybe},
+		{[]string{"--feature=off"}, No},
+		{[]string{"--feature=YES"}, Yes},
+	}
+	for _, test := range table {
+		fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+		var feature Tribool
+		TriboolVar(fs, &feature, "feature", No, "enable the feature")
+		if err := fs.Parse(test.args); err != nil {
+			t.Errorf("Parse(%v) returned error: %v", test.args, err)
+		} else if feature != test.expected {
+			t.Errorf("Parse(%v) => %s instead of the expected %s", test.args, feature, test.expected)
+		}
+	}
+}
+
+func TestTribool_pflagValue(t *testing.T) {
+	var _ pflag.Value = new(Tribool)
+
+	fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	var feature Tribool
+	TriboolVar(fs, &feature, "feature", Maybe, "enable the feature")
+	flag := fs.Lookup("feature")
+	if flag.Value.Type() != "tribool" {
+		t.Errorf("Type() => %s instead of the expected tribool", flag.Value.Type())
+	}
+	if flag.DefValue != "maybe" {
+		t.Errorf("DefValue => %s instead of the expected maybe", flag.DefValue)
+	}
+}
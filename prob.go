@@ -0,0 +1,79 @@
+package tribool
+
+/*
+Probability converts the Tribool to a probability of being true: 0 for No, 0.5
+for Maybe, and 1 for Yes.
+*/
+func (a Tribool) Probability() float64 {
+	switch a {
+	case yes:
+		return 1
+	case no:
+		return 0
+	}
+	return 0.5
+}
+
+/*
+Quantize converts a probability back to the nearest state:
+
+	              p | result
+	----------------+-------
+	       p < 0.25 | No
+	0.25 ≤ p ≤ 0.75 | Maybe
+	       0.75 < p | Yes
+	            NaN | Maybe
+
+The thresholds are halfway between 0, 0.5, and 1, and a probability exactly
+halfway rounds to Maybe.
+*/
+func Quantize(p float64) Tribool {
+	return FromFloat(p, 0.25, 0.75)
+}
+
+/*
+ProbAnd combines a and b as independent probabilities with the product t-norm,
+P(a)·P(b), and quantizes the result with Quantize.
+
+	a b | p    | ProbAnd(a, b)
+	----+------+--------------
+	N N | 0    | N
+	N ? | 0    | N
+	N Y | 0    | N
+	? N | 0    | N
+	? ? | 0.25 | ?
+	? Y | 0.5  | ?
+	Y N | 0    | N
+	Y ? | 0.5  | ?
+	Y Y | 1    | Y
+
+Because halfway rounds to Maybe, the result for two states is the same as And.
+Use Probability to combine more than two values before quantizing, where the
+result can differ: three Maybes have a product of 0.125, which is No.
+*/
+func ProbAnd(a, b Tribool) Tribool {
+	return Quantize(a.Probability() * b.Probability())
+}
+
+/*
+ProbOr combines a and b as independent probabilities with the probabilistic
+sum, P(a) + P(b) - P(a)·P(b), and quantizes the result with Quantize.
+
+	a b | p    | ProbOr(a, b)
+	----+------+--------------
+	N N | 0    | N
+	N ? | 0.5  | ?
+	N Y | 1    | Y
+	? N | 0.5  | ?
+	? ? | 0.75 | ?
+	? Y | 1    | Y
+	Y N | 1    | Y
+	Y ? | 1    | Y
+	Y Y | 1    | Y
+
+As with ProbAnd, the result for two states is the same as Or.
+*/
+func ProbOr(a, b Tribool) Tribool {
+	p, q := a.Probability(), b.Probability()
+	return Quantize(p + q - p*q)
+}
@@ -0,0 +1,61 @@
+package tribool
+
+import (
+	"math"
+	"testing"
+)
+
+func TestQuantize(t *testing.T) {
+	table := []struct {
+		p        float64
+		expected Tribool
+	}{
+		{0, No}, {0.125, No}, {0.2499, No},
+		{0.25, Maybe}, {0.5, Maybe}, {0.75, Maybe},
+		{0.7501, Yes}, {1, Yes},
+		{-1, No}, {2, Yes}, {math.NaN(), Maybe},
+	}
+	for _, test := range table {
+		if actual := Quantize(test.p); actual != test.expected {
+			t.Errorf("Quantize(%v) => %s instead of the expected %s", test.p, actual, test.expected)
+		}
+	}
+
+	for _, a := range values {
+		if actual := Quantize(a.Probability()); actual != a {
+			t.Errorf("Quantize(%s.Probability()) => %s", a, actual)
+		}
+	}
+}
+
+func TestProbAnd_ProbOr(t *testing.T) {
+	N, x, Y := No, Maybe, Yes
+	table := []struct {
+		a, b    Tribool
+		and, or Tribool
+	}{
+		{N, N, N, N},
+		{N, x, N, x},
+		{N, Y, N, Y},
+		{x, N, N, x},
+		{x, x, x, x},
+		{x, Y, x, Y},
+		{Y, N, N, Y},
+		{Y, x, x, Y},
+		{Y, Y, Y, Y},
+	}
+	for _, test := range table {
+		if actual := ProbAnd(test.a, test.b); actual != test.and {
+			t.Errorf("ProbAnd(%s, %s) => %s instead of the expected %s", test.a, test.b, actual, test.and)
+		}
+		if actual := ProbOr(test.a, test.b); actual != test.or {
+			t.Errorf("ProbOr(%s, %s) => %s instead of the expected %s", test.a, test.b, actual, test.or)
+		}
+	}
+
+	// combining probabilities before quantizing differs from Kleene logic
+	p := x.Probability() * x.Probability() * x.Probability()
+	if actual := Quantize(p); actual != N {
+		t.Errorf("Quantize of three maybes => %s instead of the expected no", actual)
+	}
+}
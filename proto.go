@@ -0,0 +1,71 @@
+package tribool
+
+/*
+ProtoEnum maps the numbers of a generated protobuf enum to Tribools, for
+converting to and from enums such as:
+
+	enum Answer {
+	  ANSWER_UNSPECIFIED = 0;
+	  ANSWER_FALSE = 1;
+	  ANSWER_TRUE = 2;
+	}
+
+FromProtoEnum and ToProtoEnum use DefaultProtoEnum. Declare a ProtoEnum when an
+enum numbers its values differently:
+
+	e := tribool.ProtoEnum{No: 0, Yes: 1, Maybe: 2}
+	flag := e.From(int32(msg.Answer))
+*/
+type ProtoEnum struct {
+	No, Maybe, Yes int32
+}
+
+/*
+DefaultProtoEnum returns the mapping of the common protobuf convention, where
+the unspecified value comes first: 0 is Maybe, 1 is No, and 2 is Yes.
+*/
+func DefaultProtoEnum() ProtoEnum {
+	return ProtoEnum{No: 1, Maybe: 0, Yes: 2}
+}
+
+/*
+From converts an enum number to a Tribool. Numbers that are not e.No or e.Yes,
+including unknown numbers from newer versions of the enum, are Maybe.
+*/
+func (e ProtoEnum) From(n int32) Tribool {
+	switch n {
+	case e.Yes:
+		return yes
+	case e.No:
+		return no
+	}
+	return maybe
+}
+
+/*
+To converts a Tribool to its enum number.
+*/
+func (e ProtoEnum) To(a Tribool) int32 {
+	switch a {
+	case yes:
+		return e.Yes
+	case no:
+		return e.No
+	}
+	return e.Maybe
+}
+
+/*
+FromProtoEnum converts an enum number to a Tribool using DefaultProtoEnum: 0 is
+Maybe, 1 is No, and 2 is Yes. Any other number is Maybe.
+*/
+func FromProtoEnum(n int32) Tribool {
+	return DefaultProtoEnum().From(n)
+}
+
+/*
+ToProtoEnum converts the Tribool to an enum number using DefaultProtoEnum.
+*/
+func (a Tribool) ToProtoEnum() int32 {
+	return DefaultProtoEnum().To(a)
+}
@@ -0,0 +1,56 @@
+package tribool
+
+import "testing"
+
+func TestFromProtoEnum(t *testing.T) {
+	table := []struct {
+		n        int32
+		expected Tribool
+	}{
+		{0, Maybe}, {1, No}, {2, Yes},
+		{3, Maybe}, {-1, Maybe}, {1 << 30, Maybe},
+	}
+	for _, test := range table {
+		if actual := FromProtoEnum(test.n); actual != test.expected {
+			t.Errorf("FromProtoEnum(%d) => %s instead of the expected %s", test.n, actual, test.expected)
+		}
+	}
+}
+
+func TestTribool_ToProtoEnum(t *testing.T) {
+	table := []struct {
+		a        Tribool
+		expected int32
+	}{
+		{No, 1}, {Maybe, 0}, {Yes, 2},
+	}
+	for _, test := range table {
+		actual := test.a.ToProtoEnum()
+		if actual != test.expected {
+			t.Errorf("%s.ToProtoEnum() => %d instead of the expected %d", test.a, actual, test.expected)
+		}
+		if back := FromProtoEnum(actual); back != test.a {
+			t.Errorf("FromProtoEnum(%s.ToProtoEnum()) => %s", test.a, back)
+		}
+	}
+}
+
+func TestProtoEnum_custom(t *testing.T) {
+	e := ProtoEnum{No: 0, Yes: 1, Maybe: 2}
+	table := []struct {
+		n        int32
+		expected Tribool
+	}{
+		{0, No}, {1, Yes}, {2, Maybe}, {7, Maybe},
+	}
+	for _, test := range table {
+		if actual := e.From(test.n); actual != test.expected {
+			t.Errorf("From(%d) => %s instead of the expected %s", test.n, actual, test.expected)
+		}
+	}
+	for _, a := range values {
+		if back := e.From(e.To(a)); back != a {
+			t.Errorf("From(To(%s)) => %s", a, back)
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package tribool
+
+import "sync"
+
+/*
+OperatorRegistry maps names to binary operators, so that an expression language
+or flag DSL can look operators up by name. It is safe for concurrent use.
+*/
+type OperatorRegistry struct {
+	mu  sync.RWMutex
+	ops map[string]func(a, b Tribool) Tribool
+}
+
+/*
+NewOperatorRegistry returns a registry holding the built-in operators: "and",
+"or", "nand", "nor", "xor", "equiv", and "implies".
+*/
+func NewOperatorRegistry() *OperatorRegistry {
+	return &OperatorRegistry{ops: map[string]func(a, b Tribool) Tribool{
+		"and":     Tribool.And,
+		"or":      Tribool.Or,
+		"nand":    Tribool.Nand,
+		"nor":     Tribool.Nor,
+		"xor":     Tribool.Xor,
+		"equiv":   Tribool.Equiv,
+		"implies": Tribool.Imply,
+	}}
+}
+
+/*
+Register adds op under name, replacing any operator already registered with
+that name, including a built-in one. It panics if op is nil.
+*/
+func (r *OperatorRegistry) Register(name string, op func(a, b Tribool) Tribool) {
+	if op == nil {
+		panic("tribool: Register of a nil operator " + name)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.ops == nil {
+		r.ops = make(map[string]func(a, b Tribool) Tribool)
+	}
+	r.ops[name] = op
+}
+
+/*
+Get returns the operator registered under name, and whether there is one.
+*/
+func (r *OperatorRegistry) Get(name string) (op func(a, b Tribool) Tribool, ok bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	op, ok = r.ops[name]
+	return op, ok
+}
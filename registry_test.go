@@ -0,0 +1,84 @@
+package tribool
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestOperatorRegistry_builtins(t *testing.T) {
+	r := NewOperatorRegistry()
+	table := []struct {
+		name string
+		op   func(a, b Tribool) Tribool
+	}{
+		{"and", Tribool.And},
+		{"or", Tribool.Or},
+		{"nand", Tribool.Nand},
+		{"nor", Tribool.Nor},
+		{"xor", Tribool.Xor},
+		{"equiv", Tribool.Equiv},
+		{"implies", Tribool.Imply},
+	}
+	for _, test := range table {
+		op, ok := r.Get(test.name)
+		if !ok {
+			t.Errorf("Get(%s) found no operator", test.name)
+			continue
+		}
+		if TruthTable(op) != TruthTable(test.op) {
+			t.Errorf("Get(%s) => an operator with the truth table %v", test.name, TruthTable(op))
+		}
+	}
+
+	if _, ok := r.Get("nope"); ok {
+		t.Errorf("Get(nope) found an operator")
+	}
+}
+
+func TestOperatorRegistry_Register(t *testing.T) {
+	r := NewOperatorRegistry()
+	r.Register("consensus", Tribool.Consensus)
+	op, ok := r.Get("consensus")
+	if !ok || op(Yes, No) != Maybe || op(Yes, Yes) != Yes {
+		t.Errorf("Get(consensus) did not return the registered operator")
+	}
+
+	r.Register("and", Tribool.Or)
+	if op, _ := r.Get("and"); op(Yes, No) != Yes {
+		t.Errorf("Register did not replace the built-in and")
+	}
+	if op, _ := NewOperatorRegistry().Get("and"); op(Yes, No) != No {
+		t.Errorf("Register on one registry changed another")
+	}
+
+	var zero OperatorRegistry
+	zero.Register("xor", Tribool.Xor)
+	if _, ok := zero.Get("xor"); !ok {
+		t.Errorf("Register on a zero OperatorRegistry did not add the operator")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Register of a nil operator did not panic")
+		}
+	}()
+	r.Register("nil", nil)
+}
+
+func TestOperatorRegistry_concurrent(t *testing.T) {
+	r := NewOperatorRegistry()
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				r.Register("custom", Tribool.Nand)
+				if _, ok := r.Get("and"); !ok {
+					t.Errorf("Get(and) found no operator")
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,173 @@
+package tribool
+
+import "fmt"
+
+/*
+TriboolSet is a sequence of Tribools packed two bits to an element, using about
+1/32 of the memory of a []Tribool.
+
+The zero value is an empty set ready to use.
+*/
+type TriboolSet struct {
+	words []uint64
+	n     int
+}
+
+const elemsPerWord = 64 / 2
+
+/*
+NewTriboolSet returns a set of n elements, all No. It panics if n is negative.
+*/
+func NewTriboolSet(n int) *TriboolSet {
+	if n < 0 {
+		panic(fmt.Sprintf("tribool: negative TriboolSet length %d", n))
+	}
+	return &TriboolSet{
+		words: make([]uint64, (n+elemsPerWord-1)/elemsPerWord),
+		n:     n,
+	}
+}
+
+/*
+Len is the number of elements in the set.
+*/
+func (s *TriboolSet) Len() int {
+	return s.n
+}
+
+/*
+Get returns the element at index i. It panics if i is out of range.
+*/
+func (s *TriboolSet) Get(i int) Tribool {
+	s.check(i)
+	word, shift := i/elemsPerWord, uint(i%elemsPerWord)*2
+	return Tribool(s.words[word] >> shift & 3)
+}
+
+/*
+Set stores v at index i. It panics if i is out of range or v is not No, Maybe,
+or Yes.
+*/
+func (s *TriboolSet) Set(i int, v Tribool) {
+	s.check(i)
+	checkValue(v)
+	word, shift := i/elemsPerWord, uint(i%elemsPerWord)*2
+	s.words[word] = s.words[word]&^(3<<shift) | uint64(v)<<shift
+}
+
+/*
+Append adds v to the end of the set. It panics if v is not No, Maybe, or Yes.
+*/
+func (s *TriboolSet) Append(v Tribool) {
+	checkValue(v)
+	if s.n%elemsPerWord == 0 && s.n/elemsPerWord == len(s.words) {
+		s.words = append(s.words, 0)
+	}
+	s.n++
+	s.Set(s.n-1, v)
+}
+
+func (s *TriboolSet) check(i int) {
+	if i < 0 || i >= s.n {
+		panic(fmt.Sprintf("tribool: index %d out of range [0:%d]", i, s.n))
+	}
+}
+
+func checkValue(v Tribool) {
+	if v < no || v > yes {
+		panic(fmt.Sprintf("tribool: invalid value %d", int(v)))
+	}
+}
+
+/*
+PackFields packs vs into bytes two bits to a value, in the same layout as
+TriboolSet: value i is in byte i/4 at bit (i%4)*2, with No as 0, Maybe as 1,
+and Yes as 2. Eight values fit in two bytes. It panics if a value is not No,
+Maybe, or Yes.
+*/
+func PackFields(vs ...Tribool) []byte {
+	data := make([]byte, (len(vs)+3)/4)
+	for i, v := range vs {
+		checkValue(v)
+		data[i/4] |= byte(v) << (uint(i%4) * 2)
+	}
+	return data
+}
+
+/*
+UnpackFields unpacks n values packed by PackFields. An error is returned if
+data is too short to hold n values, or holds a bit pattern that is not a state.
+*/
+func UnpackFields(data []byte, n int) ([]Tribool, error) {
+	if n < 0 || len(data) < (n+3)/4 {
+		return nil, fmt.Errorf("tribool: %d bytes cannot hold %d packed values", len(data), n)
+	}
+	vs := make([]Tribool, n)
+	for i := range vs {
+		v := Tribool(data[i/4] >> (uint(i%4) * 2) & 3)
+		if v > yes {
+			return nil, fmt.Errorf("tribool: invalid packed value %d at index %d", int(v), i)
+		}
+		vs[i] = v
+	}
+	return vs, nil
+}
+
+/*
+StateSet is a set of the states No, Maybe, and Yes, such as the acceptable
+states of a value. It is a 3-bit mask, so it is cheaper than a map[Tribool]bool.
+Tribool is comparable, so a map keyed by Tribool, such as a map[Tribool]int
+tally, works as well.
+
+The zero value is the empty set.
+*/
+type StateSet uint8
+
+/*
+NewStateSet returns a set holding the given states. It panics if any state is
+not No, Maybe, or Yes.
+*/
+func NewStateSet(states ...Tribool) StateSet {
+	var s StateSet
+	for _, v := range states {
+		s.Add(v)
+	}
+	return s
+}
+
+/*
+Add puts v in the set. It panics if v is not No, Maybe, or Yes.
+*/
+func (s *StateSet) Add(v Tribool) {
+	checkValue(v)
+	*s |= 1 << uint(v)
+}
+
+/*
+Remove takes v out of the set.
+*/
+func (s *StateSet) Remove(v Tribool) {
+	if s.Has(v) {
+		*s &^= 1 << uint(v)
+	}
+}
+
+/*
+Has reports whether v is in the set.
+*/
+func (s StateSet) Has(v Tribool) bool {
+	return v >= no && v <= yes && s&(1<<uint(v)) != 0
+}
+
+/*
+Slice returns the states in the set in the order No, Maybe, Yes.
+*/
+func (s StateSet) Slice() []Tribool {
+	out := make([]Tribool, 0, 3)
+	for _, v := range values {
+		if s.Has(v) {
+			out = append(out, v)
+		}
+	}
+	return out
+}
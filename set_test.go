@@ -0,0 +1,201 @@
+package tribool
+
+import "testing"
+
+func TestTriboolSet(t *testing.T) {
+	const n = 100 // crosses several word boundaries
+	s := NewTriboolSet(n)
+	if s.Len() != n {
+		t.Fatalf("NewTriboolSet(%d).Len() => %d", n, s.Len())
+	}
+	for i := 0; i < n; i++ {
+		if actual := s.Get(i); actual != No {
+			t.Errorf("new set Get(%d) => %s instead of the expected no", i, actual)
+		}
+	}
+
+	for i := 0; i < n; i++ {
+		s.Set(i, values[i%3])
+	}
+	for i := 0; i < n; i++ {
+		if actual := s.Get(i); actual != values[i%3] {
+			t.Errorf("Get(%d) => %s instead of the expected %s", i, actual, values[i%3])
+		}
+	}
+
+	// overwrite across a word boundary without disturbing neighbours
+	for _, i := range []int{31, 32, 63, 64} {
+		s.Set(i, Maybe)
+	}
+	for i := 0; i < n; i++ {
+		expected := values[i%3]
+		if i == 31 || i == 32 || i == 63 || i == 64 {
+			expected = Maybe
+		}
+		if actual := s.Get(i); actual != expected {
+			t.Errorf("Get(%d) => %s instead of the expected %s", i, actual, expected)
+		}
+	}
+}
+
+func TestTriboolSet_Append(t *testing.T) {
+	var s TriboolSet
+	var expected []Tribool
+	for i := 0; i < 70; i++ {
+		v := values[(i*7)%3]
+		s.Append(v)
+		expected = append(expected, v)
+	}
+	if s.Len() != len(expected) {
+		t.Fatalf("Len() => %d instead of the expected %d", s.Len(), len(expected))
+	}
+	for i, v := range expected {
+		if actual := s.Get(i); actual != v {
+			t.Errorf("Get(%d) => %s instead of the expected %s", i, actual, v)
+		}
+	}
+}
+
+func TestTriboolSet_outOfRange(t *testing.T) {
+	s := NewTriboolSet(3)
+	table := []struct {
+		name string
+		f    func()
+	}{
+		{"Get(-1)", func() { s.Get(-1) }},
+		{"Get(3)", func() { s.Get(3) }},
+		{"Set(3)", func() { s.Set(3, Yes) }},
+		{"Set(0, 7)", func() { s.Set(0, Tribool(7)) }},
+		{"Append(7)", func() { s.Append(Tribool(7)) }},
+		{"NewTriboolSet(-1)", func() { NewTriboolSet(-1) }},
+	}
+	for _, test := range table {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s should panic", test.name)
+				}
+			}()
+			test.f()
+		}()
+	}
+	if s.Len() != 3 {
+		t.Errorf("Len() => %d after failed calls instead of the expected 3", s.Len())
+	}
+}
+
+func BenchmarkTriboolSet_Append(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var s TriboolSet
+		for j := 0; j < 1024; j++ {
+			s.Append(values[j%3])
+		}
+	}
+}
+
+func BenchmarkSlice_Append(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var s []Tribool
+		for j := 0; j < 1024; j++ {
+			s = append(s, values[j%3])
+		}
+	}
+}
+
+func TestStateSet(t *testing.T) {
+	var s StateSet
+	for _, v := range values {
+		if s.Has(v) {
+			t.Errorf("empty set Has(%s) => true", v)
+		}
+	}
+	assertSlice(t, "empty Slice", s.Slice(), []Tribool{})
+
+	s.Add(Yes)
+	s.Add(No)
+	s.Add(Yes)
+	if !s.Has(Yes) || !s.Has(No) || s.Has(Maybe) {
+		t.Errorf("set of yes and no => %v", s.Slice())
+	}
+	assertSlice(t, "Slice", s.Slice(), []Tribool{No, Yes})
+
+	s.Remove(No)
+	s.Remove(Maybe)
+	assertSlice(t, "Slice after Remove", s.Slice(), []Tribool{Yes})
+
+	s.Add(Maybe)
+	s.Add(No)
+	assertSlice(t, "full Slice", s.Slice(), []Tribool{No, Maybe, Yes})
+
+	if s.Has(Tribool(7)) || s.Has(Tribool(-1)) {
+		t.Errorf("Has of an invalid value => true")
+	}
+	s.Remove(Tribool(7))
+	assertSlice(t, "Slice after removing an invalid value", s.Slice(), []Tribool{No, Maybe, Yes})
+}
+
+func TestNewStateSet(t *testing.T) {
+	s := NewStateSet(Yes, Maybe)
+	assertSlice(t, "NewStateSet(yes, maybe)", s.Slice(), []Tribool{Maybe, Yes})
+	if NewStateSet() != 0 {
+		t.Errorf("NewStateSet() is not the empty set")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("NewStateSet(7) should panic")
+		}
+	}()
+	NewStateSet(Tribool(7))
+}
+
+func TestPackFields_roundTrip(t *testing.T) {
+	N, x, Y := No, Maybe, Yes
+	table := []struct {
+		vs       []Tribool
+		expected []byte
+	}{
+		{nil, []byte{}},
+		{[]Tribool{Y}, []byte{0x02}},
+		{[]Tribool{Y, x, N, Y}, []byte{0x86}},
+		{[]Tribool{N, x, Y, x, Y, N, x, Y, Y}, []byte{0x64, 0x92, 0x02}},
+	}
+	for _, test := range table {
+		data := PackFields(test.vs...)
+		if string(data) != string(test.expected) {
+			t.Errorf("PackFields(%v) => %x instead of the expected %x", test.vs, data, test.expected)
+		}
+		actual, err := UnpackFields(data, len(test.vs))
+		if err != nil {
+			t.Errorf("UnpackFields(%x, %d) returned error: %v", data, len(test.vs), err)
+			continue
+		}
+		assertSlice(t, "UnpackFields(PackFields)", actual, test.vs)
+	}
+}
+
+func TestUnpackFields_errors(t *testing.T) {
+	table := []struct {
+		data []byte
+		n    int
+	}{
+		{nil, 1},
+		{[]byte{0}, 5},
+		{[]byte{0, 0}, 9},
+		{[]byte{0}, -1},
+		{[]byte{0x03}, 1},
+		{[]byte{0x00, 0xc0}, 8},
+	}
+	for _, test := range table {
+		if actual, err := UnpackFields(test.data, test.n); err == nil {
+			t.Errorf("UnpackFields(%x, %d) => %v instead of an error", test.data, test.n, actual)
+		}
+	}
+
+	// bits past n are ignored
+	if actual, err := UnpackFields([]byte{0xc2}, 1); err != nil || len(actual) != 1 || actual[0] != Yes {
+		t.Errorf("UnpackFields(c2, 1) => %v, %v instead of the expected [yes]", actual, err)
+	}
+}
@@ -0,0 +1,391 @@
+package tribool
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+/*
+WeightedVote combines votes with per-vote weights. Each Yes adds its weight and
+each No subtracts its weight; Maybe votes abstain. The result is Yes if the net
+weight exceeds threshold, No if it is below -threshold, and Maybe otherwise.
+
+An error is returned if vs and weights have different lengths.
+*/
+func WeightedVote(vs []Tribool, weights []float64, threshold float64) (Tribool, error) {
+	if len(vs) != len(weights) {
+		return maybe, errors.New("tribool: WeightedVote needs one weight per value")
+	}
+	var net float64
+	for i, v := range vs {
+		switch v {
+		case yes:
+			net += weights[i]
+		case no:
+			net -= weights[i]
+		}
+	}
+	switch {
+	case net > threshold:
+		return yes, nil
+	case net < -threshold:
+		return no, nil
+	}
+	return maybe, nil
+}
+
+/*
+Majority returns the median of a, b and c in the order No < Maybe < Yes, which
+is (a ∧ b) ∨ (b ∧ c) ∨ (a ∧ c). When two or more of the arguments agree the
+result is that state; when all three differ it is Maybe.
+*/
+func Majority(a, b, c Tribool) Tribool {
+	return a.And(b).Or(b.And(c)).Or(a.And(c))
+}
+
+/*
+Median is Majority. For three arguments the median and the majority coincide,
+and the name may read better where the arguments are ordered measurements.
+*/
+func Median(a, b, c Tribool) Tribool {
+	return Majority(a, b, c)
+}
+
+/*
+Minority returns the odd one out when exactly one of a, b and c differs from
+the other two. When all three are equal, or all three differ, there is no odd
+one out and the result is Maybe.
+
+	Minority(Yes, Yes, No)   // No
+	Minority(Maybe, No, No)  // Maybe
+	Minority(Yes, Yes, Yes)  // Maybe
+	Minority(Yes, Maybe, No) // Maybe
+*/
+func Minority(a, b, c Tribool) Tribool {
+	switch {
+	case a == b && b != c:
+		return c
+	case a == c && b != c:
+		return b
+	case b == c && a != b:
+		return a
+	}
+	return maybe
+}
+
+/*
+AnyKnown reports whether at least one element of vs is Yes or No.
+*/
+func AnyKnown(vs []Tribool) bool {
+	for _, v := range vs {
+		if v != maybe {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+AllAgree returns the known value shared by vs, ignoring its Maybe elements: it
+is Yes if every known element is Yes, No if every known element is No, and
+Maybe if there is both a Yes and a No, or no known element at all. It is the
+result of merging every element with Tribool.Merge, with a conflict giving
+Maybe.
+*/
+func AllAgree(vs []Tribool) Tribool {
+	agreed := maybe
+	for _, v := range vs {
+		var conflict bool
+		if agreed, conflict = agreed.Merge(v); conflict {
+			return maybe
+		}
+	}
+	return agreed
+}
+
+/*
+Stats counts the number of each state in a collection of Tribools.
+*/
+type Stats struct {
+	Yes, Maybe, No int
+}
+
+/*
+Summarize counts the states in vs.
+*/
+func Summarize(vs []Tribool) Stats {
+	var s Stats
+	for _, v := range vs {
+		switch v {
+		case yes:
+			s.Yes++
+		case no:
+			s.No++
+		default:
+			s.Maybe++
+		}
+	}
+	return s
+}
+
+/*
+Total is the number of values counted.
+*/
+func (s Stats) Total() int {
+	return s.Yes + s.Maybe + s.No
+}
+
+/*
+FractionKnown is the fraction of values that are Yes or No. It is 0 when no
+values were counted.
+*/
+func (s Stats) FractionKnown() float64 {
+	total := s.Total()
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Yes+s.No) / float64(total)
+}
+
+/*
+Mode is the most common state. It is Maybe when there is a tie for the most
+common state, including when no values were counted.
+*/
+func (s Stats) Mode() Tribool {
+	switch {
+	case s.Yes > s.No && s.Yes > s.Maybe:
+		return yes
+	case s.No > s.Yes && s.No > s.Maybe:
+		return no
+	}
+	return maybe
+}
+
+/*
+SortSlice sorts vs in place in the order No < Maybe < Yes.
+*/
+func SortSlice(vs []Tribool) {
+	sort.Slice(vs, func(i, j int) bool {
+		return vs[i].Less(vs[j])
+	})
+}
+
+/*
+ByTribool sorts a []Tribool in the order No < Maybe < Yes with package sort:
+
+	sort.Sort(tribool.ByTribool(vs))
+*/
+type ByTribool []Tribool
+
+func (vs ByTribool) Len() int           { return len(vs) }
+func (vs ByTribool) Less(i, j int) bool { return vs[i].Less(vs[j]) }
+func (vs ByTribool) Swap(i, j int)      { vs[i], vs[j] = vs[j], vs[i] }
+
+/*
+CompareFunc is Tribool.Compare as a plain function, for use with slices.SortFunc
+and slices.SortStableFunc. It orders No < Maybe < Yes. To sort records by a
+Tribool field:
+
+	slices.SortStableFunc(records, func(x, y Record) int {
+		return tribool.CompareFunc(x.Flag, y.Flag)
+	})
+*/
+func CompareFunc(a, b Tribool) int {
+	return a.Compare(b)
+}
+
+/*
+MapSlice returns a new slice holding f applied to each element of vs. For
+example, MapSlice(vs, Tribool.Not) negates every element.
+*/
+func MapSlice(vs []Tribool, f func(Tribool) Tribool) []Tribool {
+	out := make([]Tribool, len(vs))
+	MapSliceInto(out, vs, f)
+	return out
+}
+
+/*
+MapBools returns a new slice holding f applied to each element of vs, such as
+MapBools(vs, MaybeAsFalse) to coerce every element to a bool.
+*/
+func MapBools(vs []Tribool, f func(Tribool) bool) []bool {
+	out := make([]bool, len(vs))
+	for i, v := range vs {
+		out[i] = f(v)
+	}
+	return out
+}
+
+/*
+MapSliceInto stores f applied to each element of src in the same index of dst.
+It panics if dst is shorter than src. The dst and src slices may be the same to
+map in place without allocating.
+*/
+func MapSliceInto(dst, src []Tribool, f func(Tribool) Tribool) {
+	dst = dst[:len(src)]
+	for i, v := range src {
+		dst[i] = f(v)
+	}
+}
+
+/*
+FilterKnown returns a new slice holding the Yes and No elements of vs, in their
+original order.
+*/
+func FilterKnown(vs []Tribool) []Tribool {
+	out := make([]Tribool, 0, len(vs))
+	for _, v := range vs {
+		if v != maybe {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+/*
+FilterState returns a new slice holding the elements of vs that are the same
+state as want.
+*/
+func FilterState(vs []Tribool, want Tribool) []Tribool {
+	out := make([]Tribool, 0, len(vs))
+	for _, v := range vs {
+		if v == want {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+/*
+Partition splits vs into new slices of its Yes, Maybe, and No elements in a
+single pass. Each slice keeps the original order of its elements, and is nil
+if there are no elements of that state.
+*/
+func Partition(vs []Tribool) (yeses, maybes, nos []Tribool) {
+	for _, v := range vs {
+		switch v {
+		case yes:
+			yeses = append(yeses, v)
+		case no:
+			nos = append(nos, v)
+		default:
+			maybes = append(maybes, v)
+		}
+	}
+	return yeses, maybes, nos
+}
+
+/*
+Reduce folds vs from left to right with op, starting from identity:
+
+	op(op(op(identity, vs[0]), vs[1]), vs[2]) ...
+
+Reduce returns identity for an empty slice.
+*/
+func Reduce(vs []Tribool, op func(a, b Tribool) Tribool, identity Tribool) Tribool {
+	acc := identity
+	for _, v := range vs {
+		acc = op(acc, v)
+	}
+	return acc
+}
+
+/*
+AndAll is the logical and of every element of vs. It is Yes for an empty list.
+*/
+func AndAll(vs ...Tribool) Tribool {
+	return Reduce(vs, Tribool.And, yes)
+}
+
+/*
+OrAll is the logical inclusive-or of every element of vs. It is No for an empty
+list.
+*/
+func OrAll(vs ...Tribool) Tribool {
+	return Reduce(vs, Tribool.Or, no)
+}
+
+/*
+AndFunc is the logical and of get(0), get(1), ..., get(n-1). It stops calling
+get after the first No, since the result is then No. It is Yes when n is 0.
+*/
+func AndFunc(n int, get func(i int) Tribool) Tribool {
+	acc := yes
+	for i := 0; i < n && acc != no; i++ {
+		acc = acc.And(get(i))
+	}
+	return acc
+}
+
+/*
+OrFunc is the logical inclusive-or of get(0), get(1), ..., get(n-1). It stops
+calling get after the first Yes, since the result is then Yes. It is No when n
+is 0.
+*/
+func OrFunc(n int, get func(i int) Tribool) Tribool {
+	acc := no
+	for i := 0; i < n && acc != yes; i++ {
+		acc = acc.Or(get(i))
+	}
+	return acc
+}
+
+/*
+NotSlice returns a new slice holding the negation of each element of vs.
+*/
+func NotSlice(vs []Tribool) []Tribool {
+	out := make([]Tribool, len(vs))
+	for i, v := range vs {
+		out[i] = notTable[v.Canonicalize()]
+	}
+	return out
+}
+
+/*
+NotSliceInPlace negates each element of vs without allocating.
+*/
+func NotSliceInPlace(vs []Tribool) {
+	for i, v := range vs {
+		vs[i] = notTable[v.Canonicalize()]
+	}
+}
+
+/*
+RequireKnown returns an error naming the indices of vs that are Maybe, or nil if
+every element is Yes or No. Use it to turn unset flags into a failure at
+startup:
+
+	if err := tribool.RequireKnown(flags); err != nil {
+		log.Fatal(err) // tribool: elements [1 3] are maybe
+	}
+*/
+func RequireKnown(vs []Tribool) error {
+	var bad []int
+	for i, v := range vs {
+		if v == maybe {
+			bad = append(bad, i)
+		}
+	}
+	if bad != nil {
+		return fmt.Errorf("tribool: elements %v are maybe", bad)
+	}
+	return nil
+}
+
+/*
+RequireAll returns an error naming the indices of vs that are not want, or nil
+if every element is want.
+*/
+func RequireAll(vs []Tribool, want Tribool) error {
+	var bad []int
+	for i, v := range vs {
+		if v != want {
+			bad = append(bad, i)
+		}
+	}
+	if bad != nil {
+		return fmt.Errorf("tribool: elements %v are not %s", bad, want)
+	}
+	return nil
+}
@@ -0,0 +1,493 @@
+package tribool
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"testing"
+)
+
+func TestWeightedVote(t *testing.T) {
+	N, x, Y := No, Maybe, Yes
+	table := []struct {
+		vs        []Tribool
+		weights   []float64
+		threshold float64
+		expected  Tribool
+	}{
+		{[]Tribool{Y, Y, N}, []float64{1, 1, 1}, 0.5, Y},
+		{[]Tribool{N, N, Y}, []float64{1, 1, 1}, 0.5, N},
+		{[]Tribool{Y, N}, []float64{0.9, 0.1}, 0.5, Y},
+		{[]Tribool{Y, N}, []float64{0.6, 0.4}, 0.5, x},
+		{[]Tribool{Y, N}, []float64{1, 1}, 0, x},
+		{[]Tribool{Y, x, N}, []float64{1, 5, 1}, 0.5, x},
+		{[]Tribool{x, x, x}, []float64{1, 1, 1}, 0, x},
+		{nil, nil, 0, x},
+	}
+	for _, test := range table {
+		actual, err := WeightedVote(test.vs, test.weights, test.threshold)
+		if err != nil {
+			t.Errorf("WeightedVote(%v, %v, %v) returned error: %v", test.vs, test.weights, test.threshold, err)
+		} else if actual != test.expected {
+			t.Errorf("WeightedVote(%v, %v, %v) => %s instead of the expected %s", test.vs, test.weights, test.threshold, actual, test.expected)
+		}
+	}
+
+	if _, err := WeightedVote([]Tribool{Y, N}, []float64{1}, 0); err == nil {
+		t.Errorf("WeightedVote with mismatched lengths should return an error")
+	}
+}
+
+func TestMajority_Minority(t *testing.T) {
+	N, x, Y := No, Maybe, Yes
+	table := []struct {
+		a, b, c            Tribool
+		majority, minority Tribool
+	}{
+		{N, N, N, N, x},
+		{N, N, x, N, x},
+		{N, N, Y, N, Y},
+		{N, x, N, N, x},
+		{N, x, x, x, N},
+		{N, x, Y, x, x},
+		{N, Y, N, N, Y},
+		{N, Y, x, x, x},
+		{N, Y, Y, Y, N},
+		{x, N, N, N, x},
+		{x, N, x, x, N},
+		{x, N, Y, x, x},
+		{x, x, N, x, N},
+		{x, x, x, x, x},
+		{x, x, Y, x, Y},
+		{x, Y, N, x, x},
+		{x, Y, x, x, Y},
+		{x, Y, Y, Y, x},
+		{Y, N, N, N, Y},
+		{Y, N, x, x, x},
+		{Y, N, Y, Y, N},
+		{Y, x, N, x, x},
+		{Y, x, x, x, Y},
+		{Y, x, Y, Y, x},
+		{Y, Y, N, Y, N},
+		{Y, Y, x, Y, x},
+		{Y, Y, Y, Y, x},
+	}
+	for _, test := range table {
+		if actual := Majority(test.a, test.b, test.c); actual != test.majority {
+			t.Errorf("Majority(%s, %s, %s) => %s instead of the expected %s", test.a, test.b, test.c, actual, test.majority)
+		}
+		if actual := Median(test.a, test.b, test.c); actual != test.majority {
+			t.Errorf("Median(%s, %s, %s) => %s instead of the expected %s", test.a, test.b, test.c, actual, test.majority)
+		}
+		if actual := Minority(test.a, test.b, test.c); actual != test.minority {
+			t.Errorf("Minority(%s, %s, %s) => %s instead of the expected %s", test.a, test.b, test.c, actual, test.minority)
+		}
+	}
+}
+
+func TestAnyKnown_AllAgree(t *testing.T) {
+	N, x, Y := No, Maybe, Yes
+	table := []struct {
+		vs       []Tribool
+		anyKnown bool
+		allAgree Tribool
+	}{
+		{nil, false, x},
+		{[]Tribool{x, x, x}, false, x},
+		{[]Tribool{Y}, true, Y},
+		{[]Tribool{x, Y, x, Y}, true, Y},
+		{[]Tribool{N, x, N}, true, N},
+		{[]Tribool{Y, x, N}, true, x},
+		{[]Tribool{Y, N, Y, Y}, true, x},
+	}
+	for _, test := range table {
+		if actual := AnyKnown(test.vs); actual != test.anyKnown {
+			t.Errorf("AnyKnown(%v) => %v instead of the expected %v", test.vs, actual, test.anyKnown)
+		}
+		if actual := AllAgree(test.vs); actual != test.allAgree {
+			t.Errorf("AllAgree(%v) => %s instead of the expected %s", test.vs, actual, test.allAgree)
+		}
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	N, x, Y := No, Maybe, Yes
+	table := []struct {
+		vs       []Tribool
+		expected Stats
+		known    float64
+		mode     Tribool
+	}{
+		{nil, Stats{}, 0, x},
+		{[]Tribool{Y, Y, x, N}, Stats{Yes: 2, Maybe: 1, No: 1}, 0.75, Y},
+		{[]Tribool{N, N, x, Y}, Stats{Yes: 1, Maybe: 1, No: 2}, 0.75, N},
+		{[]Tribool{x, x, Y, N}, Stats{Yes: 1, Maybe: 2, No: 1}, 0.5, x},
+		{[]Tribool{Y, N}, Stats{Yes: 1, No: 1}, 1, x},
+		{[]Tribool{Y, Y, x, x}, Stats{Yes: 2, Maybe: 2}, 0.5, x},
+		{[]Tribool{N, x}, Stats{Maybe: 1, No: 1}, 0.5, x},
+	}
+	for _, test := range table {
+		actual := Summarize(test.vs)
+		if actual != test.expected {
+			t.Errorf("Summarize(%v) => %+v instead of the expected %+v", test.vs, actual, test.expected)
+		}
+		if total := actual.Total(); total != len(test.vs) {
+			t.Errorf("Summarize(%v).Total() => %d instead of the expected %d", test.vs, total, len(test.vs))
+		}
+		if known := actual.FractionKnown(); known != test.known {
+			t.Errorf("Summarize(%v).FractionKnown() => %v instead of the expected %v", test.vs, known, test.known)
+		}
+		if mode := actual.Mode(); mode != test.mode {
+			t.Errorf("Summarize(%v).Mode() => %s instead of the expected %s", test.vs, mode, test.mode)
+		}
+	}
+}
+
+func TestSortSlice(t *testing.T) {
+	N, x, Y := No, Maybe, Yes
+	vs := []Tribool{Y, x, N, Y, N, x, N}
+	SortSlice(vs)
+	expected := []Tribool{N, N, N, x, x, Y, Y}
+	for i := range expected {
+		if vs[i] != expected[i] {
+			t.Fatalf("SortSlice => %v instead of the expected %v", vs, expected)
+		}
+	}
+}
+
+func TestByTribool_CompareFunc(t *testing.T) {
+	N, x, Y := No, Maybe, Yes
+	expected := []Tribool{N, N, x, x, Y, Y}
+
+	vs := []Tribool{Y, x, N, Y, N, x}
+	sort.Sort(ByTribool(vs))
+	assertSlice(t, "sort.Sort(ByTribool)", vs, expected)
+
+	vs = []Tribool{Y, x, N, Y, N, x}
+	slices.SortFunc(vs, CompareFunc)
+	assertSlice(t, "slices.SortFunc(CompareFunc)", vs, expected)
+
+	type record struct {
+		name string
+		flag Tribool
+	}
+	records := []record{{"a", Y}, {"b", N}, {"c", x}, {"d", N}, {"e", Y}}
+	slices.SortStableFunc(records, func(r1, r2 record) int {
+		return CompareFunc(r1.flag, r2.flag)
+	})
+	var names string
+	for _, r := range records {
+		names += r.name
+	}
+	if names != "bdcae" {
+		t.Errorf("slices.SortStableFunc by flag => %s instead of the expected bdcae", names)
+	}
+}
+
+func TestMapSlice(t *testing.T) {
+	N, x, Y := No, Maybe, Yes
+	src := []Tribool{N, x, Y, Y}
+
+	actual := MapSlice(src, Tribool.Not)
+	expected := []Tribool{Y, x, N, N}
+	assertSlice(t, "MapSlice(Not)", actual, expected)
+	assertSlice(t, "MapSlice source", src, []Tribool{N, x, Y, Y})
+
+	actual = MapSlice(src, func(a Tribool) Tribool { return a.Or(Maybe) })
+	expected = []Tribool{x, x, Y, Y}
+	assertSlice(t, "MapSlice(Or(maybe))", actual, expected)
+
+	actual = MapSlice(nil, Tribool.Not)
+	if actual == nil || len(actual) != 0 {
+		t.Errorf("MapSlice(nil) => %#v instead of an empty slice", actual)
+	}
+}
+
+func TestMapBools(t *testing.T) {
+	N, x, Y := No, Maybe, Yes
+	src := []Tribool{N, x, Y}
+	table := []struct {
+		name     string
+		f        func(Tribool) bool
+		expected []bool
+	}{
+		{"MaybeAsTrue", MaybeAsTrue, []bool{false, true, true}},
+		{"MaybeAsFalse", MaybeAsFalse, []bool{false, false, true}},
+		{"IsMaybe", Tribool.IsMaybe, []bool{false, true, false}},
+	}
+	for _, test := range table {
+		actual := MapBools(src, test.f)
+		if fmt.Sprint(actual) != fmt.Sprint(test.expected) {
+			t.Errorf("MapBools(%v, %s) => %v instead of the expected %v", src, test.name, actual, test.expected)
+		}
+	}
+
+	if actual := MapBools(nil, MaybeAsTrue); actual == nil || len(actual) != 0 {
+		t.Errorf("MapBools(nil) => %#v instead of an empty slice", actual)
+	}
+}
+
+func TestMapSliceInto(t *testing.T) {
+	N, x, Y := No, Maybe, Yes
+	vs := []Tribool{N, x, Y}
+
+	dst := make([]Tribool, 4)
+	MapSliceInto(dst, vs, Tribool.Not)
+	assertSlice(t, "MapSliceInto", dst, []Tribool{Y, x, N, N})
+
+	MapSliceInto(vs, vs, Tribool.Not)
+	assertSlice(t, "MapSliceInto in place", vs, []Tribool{Y, x, N})
+
+	MapSliceInto(nil, nil, Tribool.Not)
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("MapSliceInto with a short dst should panic")
+		}
+	}()
+	MapSliceInto(make([]Tribool, 2), vs, Tribool.Not)
+}
+
+func assertSlice(t *testing.T, name string, actual, expected []Tribool) {
+	t.Helper()
+	if len(actual) != len(expected) {
+		t.Errorf("%s => %v instead of the expected %v", name, actual, expected)
+		return
+	}
+	for i := range expected {
+		if actual[i] != expected[i] {
+			t.Errorf("%s => %v instead of the expected %v", name, actual, expected)
+			return
+		}
+	}
+}
+
+func TestFilterKnown(t *testing.T) {
+	N, x, Y := No, Maybe, Yes
+	assertSlice(t, "FilterKnown(all maybe)", FilterKnown([]Tribool{x, x, x}), []Tribool{})
+	assertSlice(t, "FilterKnown(nil)", FilterKnown(nil), []Tribool{})
+	assertSlice(t, "FilterKnown(mixed)", FilterKnown([]Tribool{Y, x, N, x, N, Y}), []Tribool{Y, N, N, Y})
+
+	// the result must not alias the input
+	vs := []Tribool{Y, N}
+	out := FilterKnown(vs)
+	out[0] = x
+	if vs[0] != Y {
+		t.Errorf("FilterKnown result aliases its input")
+	}
+}
+
+func TestFilterState(t *testing.T) {
+	N, x, Y := No, Maybe, Yes
+	vs := []Tribool{Y, x, N, x, N, Y}
+	assertSlice(t, "FilterState(yes)", FilterState(vs, Y), []Tribool{Y, Y})
+	assertSlice(t, "FilterState(maybe)", FilterState(vs, x), []Tribool{x, x})
+	assertSlice(t, "FilterState(no)", FilterState(vs, N), []Tribool{N, N})
+	assertSlice(t, "FilterState(all maybe, yes)", FilterState([]Tribool{x, x}, Y), []Tribool{})
+}
+
+func TestPartition(t *testing.T) {
+	N, x, Y := No, Maybe, Yes
+	vs := []Tribool{Y, x, N, x, N, Y, Y}
+	yeses, maybes, nos := Partition(vs)
+	assertSlice(t, "Partition yes", yeses, []Tribool{Y, Y, Y})
+	assertSlice(t, "Partition maybe", maybes, []Tribool{x, x})
+	assertSlice(t, "Partition no", nos, []Tribool{N, N})
+
+	if total := len(yeses) + len(maybes) + len(nos); total != len(vs) {
+		t.Errorf("Partition returned %d elements instead of the expected %d", total, len(vs))
+	}
+	if s := Summarize(vs); s != (Stats{Yes: len(yeses), Maybe: len(maybes), No: len(nos)}) {
+		t.Errorf("Partition counts do not match Summarize(%v) => %+v", vs, s)
+	}
+
+	yeses, maybes, nos = Partition(nil)
+	if len(yeses) != 0 || len(maybes) != 0 || len(nos) != 0 {
+		t.Errorf("Partition(nil) => %v, %v, %v instead of empty slices", yeses, maybes, nos)
+	}
+}
+
+func TestReduce(t *testing.T) {
+	N, x, Y := No, Maybe, Yes
+	table := []struct {
+		name     string
+		vs       []Tribool
+		op       func(a, b Tribool) Tribool
+		identity Tribool
+		expected Tribool
+	}{
+		{"and", nil, Tribool.And, Y, Y},
+		{"and", []Tribool{Y, Y, Y}, Tribool.And, Y, Y},
+		{"and", []Tribool{Y, x, Y}, Tribool.And, Y, x},
+		{"and", []Tribool{Y, x, N}, Tribool.And, Y, N},
+
+		{"or", nil, Tribool.Or, N, N},
+		{"or", []Tribool{N, N, N}, Tribool.Or, N, N},
+		{"or", []Tribool{N, x, N}, Tribool.Or, N, x},
+		{"or", []Tribool{N, x, Y}, Tribool.Or, N, Y},
+
+		{"consensus", nil, Tribool.Consensus, N, N},
+		{"consensus", []Tribool{Y, Y}, Tribool.Consensus, Y, Y},
+		{"consensus", []Tribool{N, N}, Tribool.Consensus, N, N},
+		{"consensus", []Tribool{Y, N}, Tribool.Consensus, Y, x},
+		{"consensus", []Tribool{Y, Y, x}, Tribool.Consensus, Y, x},
+
+		// folds left to right
+		{"implies", []Tribool{Y, N}, Tribool.Imply, N, N},
+		{"implies", []Tribool{N, Y}, Tribool.Imply, Y, Y},
+	}
+	for _, test := range table {
+		actual := Reduce(test.vs, test.op, test.identity)
+		if actual != test.expected {
+			t.Errorf("Reduce(%v, %s, %s) => %s instead of the expected %s", test.vs, test.name, test.identity, actual, test.expected)
+		}
+	}
+}
+
+func TestAndAll_OrAll(t *testing.T) {
+	N, x, Y := No, Maybe, Yes
+	table := []struct {
+		vs      []Tribool
+		and, or Tribool
+	}{
+		{nil, Y, N},
+		{[]Tribool{x}, x, x},
+		{[]Tribool{Y, Y}, Y, Y},
+		{[]Tribool{Y, x}, x, Y},
+		{[]Tribool{N, x}, N, x},
+		{[]Tribool{N, x, Y}, N, Y},
+	}
+	for _, test := range table {
+		if actual := AndAll(test.vs...); actual != test.and {
+			t.Errorf("AndAll(%v) => %s instead of the expected %s", test.vs, actual, test.and)
+		}
+		if actual := OrAll(test.vs...); actual != test.or {
+			t.Errorf("OrAll(%v) => %s instead of the expected %s", test.vs, actual, test.or)
+		}
+	}
+}
+
+func TestAndFunc_OrFunc(t *testing.T) {
+	N, x, Y := No, Maybe, Yes
+	table := []struct {
+		vs                []Tribool
+		and, or           Tribool
+		andCalls, orCalls int
+	}{
+		{nil, Y, N, 0, 0},
+		{[]Tribool{Y, Y, Y}, Y, Y, 3, 1},
+		{[]Tribool{Y, x, Y}, x, Y, 3, 1},
+		{[]Tribool{x, N, Y}, N, Y, 2, 3},
+		{[]Tribool{N, Y, N}, N, Y, 1, 2},
+		{[]Tribool{x, x, N}, N, x, 3, 3},
+	}
+	for _, test := range table {
+		calls := 0
+		get := func(i int) Tribool {
+			calls++
+			return test.vs[i]
+		}
+
+		if actual := AndFunc(len(test.vs), get); actual != test.and {
+			t.Errorf("AndFunc(%v) => %s instead of the expected %s", test.vs, actual, test.and)
+		}
+		if calls != test.andCalls {
+			t.Errorf("AndFunc(%v) called get %d times instead of the expected %d", test.vs, calls, test.andCalls)
+		}
+
+		calls = 0
+		if actual := OrFunc(len(test.vs), get); actual != test.or {
+			t.Errorf("OrFunc(%v) => %s instead of the expected %s", test.vs, actual, test.or)
+		}
+		if calls != test.orCalls {
+			t.Errorf("OrFunc(%v) called get %d times instead of the expected %d", test.vs, calls, test.orCalls)
+		}
+	}
+}
+
+func TestNotSlice(t *testing.T) {
+	N, x, Y := No, Maybe, Yes
+	vs := []Tribool{N, x, Y, Y}
+
+	assertSlice(t, "NotSlice", NotSlice(vs), []Tribool{Y, x, N, N})
+	assertSlice(t, "NotSlice source", vs, []Tribool{N, x, Y, Y})
+	assertSlice(t, "NotSlice(nil)", NotSlice(nil), []Tribool{})
+
+	NotSliceInPlace(vs)
+	assertSlice(t, "NotSliceInPlace", vs, []Tribool{Y, x, N, N})
+	NotSliceInPlace(nil)
+}
+
+func benchSlice() []Tribool {
+	vs := make([]Tribool, 1024)
+	for i := range vs {
+		vs[i] = values[i%3]
+	}
+	return vs
+}
+
+func BenchmarkNotSliceInPlace(b *testing.B) {
+	vs := benchSlice()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		NotSliceInPlace(vs)
+	}
+}
+
+func BenchmarkNotSliceInPlace_loop(b *testing.B) {
+	vs := benchSlice()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for j, v := range vs {
+			vs[j] = v.Not()
+		}
+	}
+}
+
+func BenchmarkNotSlice(b *testing.B) {
+	vs := benchSlice()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		NotSlice(vs)
+	}
+}
+
+func TestRequireKnown(t *testing.T) {
+	N, x, Y := No, Maybe, Yes
+	table := []struct {
+		vs       []Tribool
+		expected string
+	}{
+		{nil, ""},
+		{[]Tribool{Y, N, Y}, ""},
+		{[]Tribool{Y, x, N, x}, "tribool: elements [1 3] are maybe"},
+		{[]Tribool{x}, "tribool: elements [0] are maybe"},
+	}
+	for _, test := range table {
+		err := RequireKnown(test.vs)
+		if actual := fmt.Sprint(err); (err == nil) != (test.expected == "") || (err != nil && actual != test.expected) {
+			t.Errorf("RequireKnown(%v) => %v instead of the expected %q", test.vs, err, test.expected)
+		}
+	}
+}
+
+func TestRequireAll(t *testing.T) {
+	N, x, Y := No, Maybe, Yes
+	table := []struct {
+		vs       []Tribool
+		want     Tribool
+		expected string
+	}{
+		{nil, Y, ""},
+		{[]Tribool{Y, Y}, Y, ""},
+		{[]Tribool{Y, x, N, Y}, Y, "tribool: elements [1 2] are not yes"},
+		{[]Tribool{N, x, N}, N, "tribool: elements [1] are not no"},
+		{[]Tribool{x, x}, x, ""},
+	}
+	for _, test := range table {
+		err := RequireAll(test.vs, test.want)
+		if actual := fmt.Sprint(err); (err == nil) != (test.expected == "") || (err != nil && actual != test.expected) {
+			t.Errorf("RequireAll(%v, %s) => %v instead of the expected %q", test.vs, test.want, err, test.expected)
+		}
+	}
+}
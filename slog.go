@@ -0,0 +1,13 @@
+package tribool
+
+import "log/slog"
+
+/*
+LogValue implements slog.LogValuer, so that a Tribool is logged as the string
+"yes", "no", or "maybe" by every slog.Handler:
+
+	slog.Info("feature", "enabled", flag) // enabled=yes
+*/
+func (a Tribool) LogValue() slog.Value {
+	return slog.StringValue(a.String())
+}
@@ -0,0 +1,38 @@
+package tribool
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestTribool_LogValue(t *testing.T) {
+	for _, a := range values {
+		if v := a.LogValue(); v.Kind() != slog.KindString || v.String() != a.String() {
+			t.Errorf("%s.LogValue() => %v of kind %s instead of the string %s", a, v, v.Kind(), a)
+		}
+	}
+
+	var buf bytes.Buffer
+	removeTime := func(groups []string, a slog.Attr) slog.Attr {
+		if a.Key == slog.TimeKey {
+			return slog.Attr{}
+		}
+		return a
+	}
+
+	text := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{ReplaceAttr: removeTime}))
+	text.Info("flags", "a", Yes, slog.Any("b", Maybe), "c", No)
+	expected := "level=INFO msg=flags a=yes b=maybe c=no\n"
+	if buf.String() != expected {
+		t.Errorf("text handler => %q instead of the expected %q", buf.String(), expected)
+	}
+
+	buf.Reset()
+	jsonLogger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{ReplaceAttr: removeTime}))
+	jsonLogger.Info("flags", "a", Yes)
+	if actual := strings.TrimSpace(buf.String()); actual != `{"level":"INFO","msg":"flags","a":"yes"}` {
+		t.Errorf("json handler => %s", actual)
+	}
+}
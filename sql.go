@@ -0,0 +1,98 @@
+package tribool
+
+import (
+	"errors"
+	"fmt"
+)
+
+/*
+Scan implements sql.Scanner so a Tribool can be read from a nullable boolean
+column. NULL is Maybe. Drivers represent booleans in several ways, all of which
+are accepted:
+
+	    source | result
+	-----------+---------------------------------------
+	       nil | Maybe
+	      bool | FromBool
+	     int64 | zero is No, anything else is Yes
+	   float64 | zero is No, other whole numbers are Yes, fractions are Maybe
+	    string | FromString
+	    []byte | a raw bit, or text parsed with FromBytes
+
+A []byte of exactly one byte that is 0x00 or 0x01 is a raw bit, as MySQL
+returns for BIT(1) columns. Those bytes are never printable text, so they cannot
+be confused with text like "0", "1", "t", or "true" returned by other drivers.
+
+Any other source type is an error.
+*/
+func (a *Tribool) Scan(src interface{}) error {
+	if a == nil {
+		return errors.New("tribool.TriBool: Scan on nil pointer")
+	}
+	switch v := src.(type) {
+	case nil:
+		*a = maybe
+	case bool:
+		*a = FromBool(v)
+	case int64:
+		*a = FromBool(v != 0)
+	case float64:
+		*a = fromNumber(v)
+	case string:
+		*a = FromString(v)
+	case []byte:
+		if len(v) == 1 && v[0] <= 1 {
+			*a = FromBool(v[0] == 1)
+		} else {
+			*a = FromBytes(v)
+		}
+	default:
+		return fmt.Errorf("tribool.TriBool: cannot scan %T into a Tribool", src)
+	}
+	return nil
+}
+
+/*
+SqlEqual compares a and b as SQL's = operator does, treating Maybe as NULL: any
+comparison with NULL is NULL, even NULL = NULL. It is the same as Equiv.
+
+	a b | a.SqlEqual(b)
+	----+--------------
+	N N | Y
+	N ? | ?
+	N Y | N
+	? N | ?
+	? ? | ?
+	? Y | ?
+	Y N | N
+	Y ? | ?
+	Y Y | Y
+*/
+func (a Tribool) SqlEqual(b Tribool) Tribool {
+	return a.Equiv(b)
+}
+
+/*
+SqlAnd is SQL's AND with Maybe as NULL. SQL's truth tables for NULL are
+Kleene's, so it is the same as And: FALSE AND NULL is FALSE, and TRUE AND NULL
+is NULL.
+*/
+func (a Tribool) SqlAnd(b Tribool) Tribool {
+	return a.And(b)
+}
+
+/*
+SqlOr is SQL's OR with Maybe as NULL. It is the same as Or: TRUE OR NULL is
+TRUE, and FALSE OR NULL is NULL.
+*/
+func (a Tribool) SqlOr(b Tribool) Tribool {
+	return a.Or(b)
+}
+
+/*
+IsNull reports whether a is Maybe, which stands for SQL's NULL. It is the same
+as IsMaybe.
+*/
+func (a Tribool) IsNull() bool {
+	return a.IsMaybe()
+}
@@ -0,0 +1,101 @@
+package tribool
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+)
+
+func TestTribool_Scan(t *testing.T) {
+	var _ sql.Scanner = new(Tribool)
+
+	table := []struct {
+		name     string
+		src      interface{}
+		expected Tribool
+	}{
+		{"null", nil, Maybe},
+		{"bool", true, Yes},
+		{"bool", false, No},
+		{"int64", int64(1), Yes},
+		{"int64", int64(0), No},
+		{"int64", int64(-1), Yes},
+		{"float64", float64(1), Yes},
+		{"float64", float64(0), No},
+		{"float64", 0.5, Maybe},
+		{"string", "true", Yes},
+		{"string", "f", No},
+		{"string", "", Maybe},
+
+		// MySQL BIT(1)
+		{"bit", []byte{0x01}, Yes},
+		{"bit", []byte{0x00}, No},
+
+		// Postgres and other text booleans
+		{"text", []byte("t"), Yes},
+		{"text", []byte("f"), No},
+		{"text", []byte("1"), Yes},
+		{"text", []byte("0"), No},
+		{"text", []byte("true"), Yes},
+		{"text", []byte("FALSE"), No},
+		{"text", []byte{}, Maybe},
+		{"text", []byte{0x02}, Maybe},
+		{"text", []byte{0x00, 0x01}, Maybe},
+	}
+	for _, test := range table {
+		tri := Tribool(-1)
+		if err := tri.Scan(test.src); err != nil {
+			t.Errorf("Scan(%s %#v) returned error: %v", test.name, test.src, err)
+		} else if tri != test.expected {
+			t.Errorf("Scan(%s %#v) => %s instead of the expected %s", test.name, test.src, tri, test.expected)
+		}
+	}
+}
+
+func TestTribool_Scan_invalid(t *testing.T) {
+	for _, src := range []interface{}{time.Now(), int32(1), struct{}{}} {
+		var tri Tribool
+		if err := tri.Scan(src); err == nil {
+			t.Errorf("Scan(%T) => %s instead of an error", src, tri)
+		}
+	}
+}
+
+func TestTribool_sqlLogic(t *testing.T) {
+	// T, F, and NULL as in SQL's truth tables
+	T, F, NULL := Yes, No, Maybe
+	table := []struct {
+		a, b        Tribool
+		eq, and, or Tribool
+	}{
+		{T, T, T, T, T},
+		{T, F, F, F, T},
+		{T, NULL, NULL, NULL, T},
+		{F, T, F, F, T},
+		{F, F, T, F, F},
+		{F, NULL, NULL, F, NULL},
+		{NULL, T, NULL, NULL, T},
+		{NULL, F, NULL, F, NULL},
+		{NULL, NULL, NULL, NULL, NULL},
+	}
+	for _, test := range table {
+		if actual := test.a.SqlEqual(test.b); actual != test.eq {
+			t.Errorf("(%s = %s) => %s instead of the expected %s", test.a, test.b, actual, test.eq)
+		}
+		if actual := test.a.SqlAnd(test.b); actual != test.and {
+			t.Errorf("(%s AND %s) => %s instead of the expected %s", test.a, test.b, actual, test.and)
+		}
+		if actual := test.a.SqlOr(test.b); actual != test.or {
+			t.Errorf("(%s OR %s) => %s instead of the expected %s", test.a, test.b, actual, test.or)
+		}
+	}
+}
+
+func TestTribool_IsNull(t *testing.T) {
+	for _, a := range values {
+		expected := a == Maybe
+		if a.IsNull() != expected || a.IsMaybe() != expected {
+			t.Errorf("%s.IsNull(), %s.IsMaybe() => %v, %v instead of the expected %v", a, a, a.IsNull(), a.IsMaybe(), expected)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package tribool
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"unicode"
+)
+
+/*
+Decoder reads a stream of whitespace-separated Tribools, such as one per line.
+*/
+type Decoder struct {
+	scanner  *bufio.Scanner
+	skipping bool // in the middle of a token too long to buffer
+}
+
+/*
+NewDecoder returns a Decoder that reads from r.
+*/
+func NewDecoder(r io.Reader) *Decoder {
+	d := &Decoder{scanner: bufio.NewScanner(r)}
+	d.scanner.Buffer(nil, bufio.MaxScanTokenSize)
+	d.scanner.Split(d.split)
+	return d
+}
+
+// split is bufio.ScanWords, except that a token too long for the buffer is
+// skipped and returned as an empty token, which is Maybe, rather than stopping
+// the scanner with bufio.ErrTooLong.
+func (d *Decoder) split(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	if d.skipping {
+		if i := bytes.IndexFunc(data, unicode.IsSpace); i >= 0 {
+			d.skipping = false
+			return i, []byte{}, nil
+		}
+		if atEOF {
+			d.skipping = false
+			return len(data), []byte{}, nil
+		}
+		return len(data), nil, nil
+	}
+
+	advance, token, err = bufio.ScanWords(data, atEOF)
+	if advance == 0 && token == nil && err == nil && len(data) >= bufio.MaxScanTokenSize {
+		d.skipping = true
+		return len(data), nil, nil
+	}
+	return advance, token, err
+}
+
+/*
+Decode reads the next token and parses it with FromBytes, so unrecognized tokens
+are Maybe rather than an error, even tokens too long to buffer. It returns
+io.EOF when there are no more tokens, or any error from the underlying reader.
+*/
+func (d *Decoder) Decode() (Tribool, error) {
+	if !d.scanner.Scan() {
+		if err := d.scanner.Err(); err != nil {
+			return maybe, err
+		}
+		return maybe, io.EOF
+	}
+	return FromBytes(d.scanner.Bytes()), nil
+}
+
+/*
+Encoder writes a stream of Tribools, each followed by a separator.
+*/
+type Encoder struct {
+	w   io.Writer
+	sep string
+	buf []byte
+}
+
+/*
+EncoderOption configures an Encoder.
+*/
+type EncoderOption func(*Encoder)
+
+/*
+WithSeparator sets the text written after each Tribool. The default is a
+newline.
+*/
+func WithSeparator(sep string) EncoderOption {
+	return func(e *Encoder) {
+		e.sep = sep
+	}
+}
+
+/*
+NewEncoder returns an Encoder that writes to w.
+*/
+func NewEncoder(w io.Writer, opts ...EncoderOption) *Encoder {
+	e := &Encoder{w: w, sep: "\n"}
+	for _, opt := range opts {
+		opt(e)
+	}
+	return e
+}
+
+/*
+Encode writes the String of a followed by the separator. It returns any error
+from the underlying writer.
+*/
+func (e *Encoder) Encode(a Tribool) error {
+	e.buf, _ = a.AppendText(e.buf[:0])
+	e.buf = append(e.buf, e.sep...)
+	_, err := e.w.Write(e.buf)
+	return err
+}
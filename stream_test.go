@@ -0,0 +1,136 @@
+package tribool
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestDecoder(t *testing.T) {
+	input := "yes\nno\n\n  maybe\tTRUE off\r\ngarbage\n0"
+	expected := []Tribool{Yes, No, Maybe, Yes, No, Maybe, No}
+
+	d := NewDecoder(strings.NewReader(input))
+	var actual []Tribool
+	for {
+		tri, err := d.Decode()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Decode returned error: %v", err)
+		}
+		actual = append(actual, tri)
+	}
+	assertSlice(t, "Decode", actual, expected)
+
+	if _, err := d.Decode(); err != io.EOF {
+		t.Errorf("Decode after the end => %v instead of the expected io.EOF", err)
+	}
+}
+
+func TestDecoder_empty(t *testing.T) {
+	d := NewDecoder(strings.NewReader("  \n\n"))
+	if _, err := d.Decode(); err != io.EOF {
+		t.Errorf("Decode of blank input => %v instead of the expected io.EOF", err)
+	}
+}
+
+func TestDecoder_readError(t *testing.T) {
+	errBroken := errors.New("broken pipe")
+	d := NewDecoder(io.MultiReader(strings.NewReader("yes "), &errReader{errBroken}))
+	if tri, err := d.Decode(); err != nil || tri != Yes {
+		t.Errorf("Decode => %s, %v instead of the expected yes", tri, err)
+	}
+	if _, err := d.Decode(); err != errBroken {
+		t.Errorf("Decode => %v instead of the expected %v", err, errBroken)
+	}
+}
+
+func TestDecoder_longToken(t *testing.T) {
+	input := "yes " + strings.Repeat("x", 70000) + " no " + strings.Repeat("y", 70000)
+	expected := []Tribool{Yes, Maybe, No, Maybe}
+
+	d := NewDecoder(strings.NewReader(input))
+	var actual []Tribool
+	for {
+		tri, err := d.Decode()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Decode returned error: %v", err)
+		}
+		actual = append(actual, tri)
+	}
+	assertSlice(t, "Decode", actual, expected)
+}
+
+type errReader struct {
+	err error
+}
+
+func (r *errReader) Read(p []byte) (int, error) {
+	return 0, r.err
+}
+
+func TestEncoder(t *testing.T) {
+	table := []struct {
+		opts     []EncoderOption
+		expected string
+	}{
+		{nil, "yes\nmaybe\nno\n"},
+		{[]EncoderOption{WithSeparator(",")}, "yes,maybe,no,"},
+		{[]EncoderOption{WithSeparator("")}, "yesmaybeno"},
+	}
+	for _, test := range table {
+		var buf bytes.Buffer
+		e := NewEncoder(&buf, test.opts...)
+		for _, tri := range []Tribool{Yes, Maybe, No} {
+			if err := e.Encode(tri); err != nil {
+				t.Fatalf("Encode(%s) returned error: %v", tri, err)
+			}
+		}
+		if buf.String() != test.expected {
+			t.Errorf("Encode => %q instead of the expected %q", buf.String(), test.expected)
+		}
+	}
+}
+
+func TestEncoder_writeError(t *testing.T) {
+	errBroken := errors.New("broken pipe")
+	e := NewEncoder(&errWriter{errBroken})
+	if err := e.Encode(Yes); err != errBroken {
+		t.Errorf("Encode => %v instead of the expected %v", err, errBroken)
+	}
+}
+
+func TestEncoder_Decoder(t *testing.T) {
+	var buf bytes.Buffer
+	e := NewEncoder(&buf, WithSeparator(" "))
+	expected := []Tribool{No, Yes, Maybe, Maybe, Yes}
+	for _, tri := range expected {
+		e.Encode(tri)
+	}
+
+	d := NewDecoder(&buf)
+	var actual []Tribool
+	for {
+		tri, err := d.Decode()
+		if err != nil {
+			break
+		}
+		actual = append(actual, tri)
+	}
+	assertSlice(t, "Encode then Decode", actual, expected)
+}
+
+type errWriter struct {
+	err error
+}
+
+func (w *errWriter) Write(p []byte) (int, error) {
+	return 0, w.err
+}
@@ -0,0 +1,24 @@
+package tribool
+
+import "text/template"
+
+/*
+FuncMap returns template functions for working with Tribools:
+
+	isTrue   reports whether a Tribool is Yes
+	isMaybe  reports whether a Tribool is Maybe
+	isFalse  reports whether a Tribool is No
+	triLabel converts a Tribool to its String
+
+For example:
+
+	{{if isMaybe .Flag}}unknown{{else}}{{triLabel .Flag}}{{end}}
+*/
+func FuncMap() template.FuncMap {
+	return template.FuncMap{
+		"isTrue":   func(a Tribool) bool { return a == yes },
+		"isMaybe":  func(a Tribool) bool { return a == maybe },
+		"isFalse":  func(a Tribool) bool { return a == no },
+		"triLabel": Tribool.String,
+	}
+}
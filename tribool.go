@@ -22,8 +22,8 @@ default value. For example:
 	var flag bool = tribool.FromString(x).WithMaybeAsFalse()
 
 Parsing is case insensitive. The following table shows what will be parsed to
-true and false values, anything else (including the empty string) results in
-the indeterminate value.
+true, false, and explicitly indeterminate values. Anything else (including the
+empty string) also results in the indeterminate value.
 
 	case insensitive | result
 	-----------------+-------
@@ -39,6 +39,13 @@ the indeterminate value.
 	              no | No
 	             off | No
 	           false | No
+	               ? | Maybe
+	             nil | Maybe
+	            null | Maybe
+	           maybe | Maybe
+	         perhaps | Maybe
+	         unknown | Maybe
+	   indeterminate | Maybe
 	 <anything else> | Maybe
 
 
@@ -72,7 +79,12 @@ Tribool supports the following unary operations:
 package tribool
 
 import (
+	"bytes"
 	"errors"
+	"fmt"
+	"iter"
+	"math"
+	"math/rand"
 
 	"encoding/json"
 )
@@ -80,7 +92,8 @@ import (
 /*
 Tribool is a tri-state boolean where the extra state is indeterminate.
 
-The default value for a Tribool is False, just like a boolean.
+The default value for a Tribool is False, just like a boolean. See MaybeDefault
+for a type whose default value is Maybe.
 */
 type Tribool int
 
@@ -124,7 +137,56 @@ const (
 )
 
 var values = [3]Tribool{No, Maybe, Yes}
-var strings = [3]string{"no", "maybe", "yes"}
+var words = [3]string{"no", "maybe", "yes"}
+
+/*
+Values returns every state in the order No, Maybe, Yes. The result is a copy,
+so ranging over it is safe:
+
+	for _, a := range tribool.Values() { ... }
+*/
+func Values() [3]Tribool {
+	return values
+}
+
+/*
+States returns an iterator over every state in the order No, Maybe, Yes:
+
+	for a := range tribool.States() { ... }
+*/
+func States() iter.Seq[Tribool] {
+	return func(yield func(Tribool) bool) {
+		for _, a := range values {
+			if !yield(a) {
+				return
+			}
+		}
+	}
+}
+
+/*
+New converts an integer code to a Tribool: 0 is No, 1 is Maybe, and 2 is Yes.
+Any other code is an error. A plain conversion such as Tribool(n) is not
+checked, so use New for integers from untrusted sources, such as database
+codes.
+*/
+func New(n int) (Tribool, error) {
+	if n < int(no) || n > int(yes) {
+		return maybe, fmt.Errorf("tribool: invalid value %d", n)
+	}
+	return values[n], nil
+}
+
+/*
+MustNew is like New but panics if n is not 0, 1, or 2.
+*/
+func MustNew(n int) Tribool {
+	a, err := New(n)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
 
 /*
 FromBool converts a bool to an equivalent Tribool.
@@ -140,7 +202,129 @@ func FromBool(b bool) Tribool {
 String converts a Tribool to a string that can be parsed with FromString
 */
 func (a Tribool) String() string {
-	return strings[a]
+	return words[a]
+}
+
+var titles = [3]string{"No", "Maybe", "Yes"}
+
+/*
+Title converts a Tribool to a capitalized word for display: No, Maybe, or Yes.
+Unlike String, it is not meant to be parsed.
+*/
+func (a Tribool) Title() string {
+	return titles[a]
+}
+
+var trueFalses = [3]string{"False", "Unknown", "True"}
+
+/*
+TrueFalse converts a Tribool to a capitalized word for display: False, Unknown,
+or True. Unlike String, it is not meant to be parsed.
+*/
+func (a Tribool) TrueFalse() string {
+	return trueFalses[a]
+}
+
+/*
+MarshalText converts a Tribool to the same text as String.
+
+Together with UnmarshalText, this lets a Tribool be the key of a map encoded as
+JSON: a map[Tribool]int is encoded as an object with keys "no", "maybe", and
+"yes".
+*/
+func (a Tribool) MarshalText() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+/*
+UnmarshalText converts text to a Tribool using FromBytes.
+*/
+func (a *Tribool) UnmarshalText(text []byte) error {
+	if a == nil {
+		return errors.New("tribool.TriBool: UnmarshalText on nil pointer")
+	}
+	*a = FromBytes(text)
+	return nil
+}
+
+/*
+AppendText appends the same text as MarshalText to dst and returns the extended
+buffer. It implements encoding.TextAppender and never returns an error.
+*/
+func (a Tribool) AppendText(dst []byte) ([]byte, error) {
+	return append(dst, words[a]...), nil
+}
+
+/*
+MarshalBinary encodes a Tribool as a single byte: 0 for No, 1 for Maybe, and 2
+for Yes. It implements encoding.BinaryMarshaler, so it is the form used by
+encoding/gob. An error is returned if a is not one of those states.
+*/
+func (a Tribool) MarshalBinary() ([]byte, error) {
+	if !a.IsValid() {
+		return nil, fmt.Errorf("tribool.TriBool: cannot marshal invalid value %d", int(a))
+	}
+	return []byte{byte(a)}, nil
+}
+
+/*
+UnmarshalBinary decodes the single byte written by MarshalBinary. Any other
+input is an error.
+*/
+func (a *Tribool) UnmarshalBinary(data []byte) error {
+	if a == nil {
+		return errors.New("tribool.TriBool: UnmarshalBinary on nil pointer")
+	}
+	if len(data) != 1 || data[0] > byte(yes) {
+		return fmt.Errorf("tribool.TriBool: cannot unmarshal binary %x into a Tribool", data)
+	}
+	*a = Tribool(data[0])
+	return nil
+}
+
+var symbols = [3]string{"N", "?", "Y"}
+
+/*
+Symbol converts a Tribool to the single character used in the truth tables:
+N, ?, or Y.
+*/
+func (a Tribool) Symbol() string {
+	return symbols[a]
+}
+
+/*
+FromSymbol converts a symbol from Symbol back to a Tribool: Y is Yes, N is No,
+and anything else is Maybe.
+*/
+func FromSymbol(s string) Tribool {
+	switch s {
+	case "Y":
+		return yes
+	case "N":
+		return no
+	}
+	return maybe
+}
+
+/*
+AppendSymbol appends the text of a.Symbol() to dst and returns the extended
+buffer.
+*/
+func (a Tribool) AppendSymbol(dst []byte) []byte {
+	return append(dst, symbols[a]...)
+}
+
+var goStrings = [3]string{"tribool.No", "tribool.Maybe", "tribool.Yes"}
+
+/*
+GoString converts a Tribool to the name of its constant, for use with the %#v
+format verb. Invalid values are printed as a conversion, e.g. tribool.Tribool(7)
+*/
+func (a Tribool) GoString() string {
+	if a < no || a > yes {
+		return fmt.Sprintf("tribool.Tribool(%d)", int(a))
+	}
+	return goStrings[a]
 }
 
 /*
@@ -169,6 +353,90 @@ func (a Tribool) WithMaybeAsFalse() bool {
 	return a == yes
 }
 
+/*
+WithMaybeAs converts the Tribool to a boolean by coercing Maybe to b. It is
+WithMaybeAsTrue when b is true and WithMaybeAsFalse when b is false, for when
+the choice is only known at run time.
+
+		a | a.WithMaybeAs(b)
+		--+----------------------
+		N | N
+		? | b
+		Y | Y
+*/
+func (a Tribool) WithMaybeAs(b bool) bool {
+	if a == maybe {
+		return b
+	}
+	return a == yes
+}
+
+/*
+ErrMaybe is returned by MustBool when the Tribool is Maybe.
+*/
+var ErrMaybe = errors.New("tribool.TriBool: value is maybe, not true or false")
+
+/*
+MustBool converts the Tribool to a boolean without coercing Maybe. It returns
+the value of Yes or No, and ErrMaybe for Maybe, so that the caller can fail
+rather than silently pick a default.
+*/
+func (a Tribool) MustBool() (bool, error) {
+	if a == maybe {
+		return false, ErrMaybe
+	}
+	return a == yes, nil
+}
+
+/*
+MustBoolStrict is like MustBool but panics for Maybe, for initializers where
+Maybe is a programming error.
+*/
+func (a Tribool) MustBoolStrict() bool {
+	b, err := a.MustBool()
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+/*
+MaybeAsTrue is equivalent to a.WithMaybeAsTrue(), as a plain function that can
+be passed to helpers such as MapBools.
+*/
+func MaybeAsTrue(a Tribool) bool {
+	return a.WithMaybeAsTrue()
+}
+
+/*
+MaybeAsFalse is equivalent to a.WithMaybeAsFalse(), as a plain function that can
+be passed to helpers such as MapBools.
+*/
+func MaybeAsFalse(a Tribool) bool {
+	return a.WithMaybeAsFalse()
+}
+
+/*
+Resolve converts the Tribool to a boolean by sampling Maybe. Yes and No return
+their definite values; Maybe returns true with probability pTrue, using r as the
+source of randomness. A nil r uses the default source from math/rand.
+
+		a | a.Resolve(r, p)
+		--+----------------------
+		N | N
+		? | Y with probability p
+		Y | Y
+*/
+func (a Tribool) Resolve(r *rand.Rand, pTrue float64) bool {
+	if a != maybe {
+		return a == yes
+	}
+	if r == nil {
+		return rand.Float64() < pTrue
+	}
+	return r.Float64() < pTrue
+}
+
 /*
 And implements logical and.
 
@@ -184,9 +452,12 @@ And implements logical and.
 		Y N | N
 		Y ? | ?
 		Y Y | Y
+
+Out-of-range values are clamped as by Canonicalize rather than panicking, so a
+corrupt value below No is No and one above Yes is Yes.
 */
 func (a Tribool) And(b Tribool) Tribool {
-	return values[min(a, b)]
+	return min(a, b).Canonicalize()
 }
 
 func min(a, b Tribool) Tribool {
@@ -218,9 +489,11 @@ Or implements logical inclusive-or.
 		Y N | Y
 		Y ? | Y
 		Y Y | Y
+
+Out-of-range values are clamped as by Canonicalize, as in And.
 */
 func (a Tribool) Or(b Tribool) Tribool {
-	return values[max(a, b)]
+	return max(a, b).Canonicalize()
 }
 
 func max(a, b Tribool) Tribool {
@@ -230,6 +503,22 @@ func max(a, b Tribool) Tribool {
 	return b
 }
 
+/*
+Min returns the lesser of a and b in the order No < Maybe < Yes, which is their
+meet in that lattice. It is equivalent to a.And(b).
+*/
+func Min(a, b Tribool) Tribool {
+	return a.And(b)
+}
+
+/*
+Max returns the greater of a and b in the order No < Maybe < Yes, which is their
+join in that lattice. It is equivalent to a.Or(b).
+*/
+func Max(a, b Tribool) Tribool {
+	return a.Or(b)
+}
+
 /*
 OrBool is equivalent to a.Or(FromBool(b))
 */
@@ -252,9 +541,11 @@ Nand implements logical nand.
 		Y N | Y
 		Y ? | ?
 		Y Y | N
+
+Out-of-range values are clamped as by Canonicalize, as in And.
 */
 func (a Tribool) Nand(b Tribool) Tribool {
-	return values[2-min(a, b)]
+	return values[2-min(a, b).Canonicalize()]
 }
 
 /*
@@ -264,6 +555,23 @@ func (a Tribool) NandBool(b bool) Tribool {
 	return a.Nand(FromBool(b))
 }
 
+/*
+Stroke is the Sheffer stroke, a | b, and is the same as Nand. Like Nand it is
+functionally complete for Yes and No: every boolean operation can be written
+using only Stroke. It is not complete for three values, since it never makes
+Maybe from Yes and No.
+*/
+func (a Tribool) Stroke(b Tribool) Tribool {
+	return a.Nand(b)
+}
+
+// Lookup tables for the unary operations, indexed by the operand.
+var (
+	notTable       = [3]Tribool{yes, maybe, no}
+	upgradeTable   = [3]Tribool{no, yes, yes}
+	downgradeTable = [3]Tribool{no, no, yes}
+)
+
 /*
 Not implements logical not.
 
@@ -272,9 +580,72 @@ Not implements logical not.
 		 N | Y
 		 ? | ?
 		 Y | N
+
+Out-of-range values are clamped as by Canonicalize, as in And, so the
+operators built on Not, And, and Or, such as Imply and Xor, never panic.
 */
 func (a Tribool) Not() Tribool {
-	return values[2-a]
+	return notTable[a.Canonicalize()]
+}
+
+/*
+Upgrade resolves Maybe to Yes. It is equivalent to FromBool(a.WithMaybeAsTrue())
+
+		 a | a.Upgrade()
+		 --+------------
+		 N | N
+		 ? | Y
+		 Y | Y
+*/
+func (a Tribool) Upgrade() Tribool {
+	return upgradeTable[a.Canonicalize()]
+}
+
+/*
+Downgrade resolves Maybe to No. It is equivalent to FromBool(a.WithMaybeAsFalse())
+
+		 a | a.Downgrade()
+		 --+--------------
+		 N | N
+		 ? | N
+		 Y | Y
+*/
+func (a Tribool) Downgrade() Tribool {
+	return downgradeTable[a.Canonicalize()]
+}
+
+/*
+Weaken moves a one step toward Maybe, forgetting a definite value. It is
+idempotent, since Maybe stays Maybe, so a.Weaken().Weaken() is a.Weaken().
+
+		 a | a.Weaken()
+		 --+-----------
+		 N | ?
+		 ? | ?
+		 Y | ?
+
+Unlike Upgrade and Downgrade, which only ever resolve Maybe, Weaken and
+Strengthen move between Maybe and a definite value in either direction.
+*/
+func (a Tribool) Weaken() Tribool {
+	return maybe
+}
+
+/*
+Strengthen resolves Maybe to toward, and leaves Yes and No unchanged. It is
+Upgrade when toward is Yes and Downgrade when toward is No.
+
+		 a | a.Strengthen(t)
+		 --+----------------
+		 N | N
+		 ? | t
+		 Y | Y
+*/
+func (a Tribool) Strengthen(toward Tribool) Tribool {
+	if a == maybe {
+		return toward
+	}
+	return a
 }
 
 /*
@@ -292,9 +663,11 @@ Nor implements logical nor.
 		Y N | N
 		Y ? | N
 		Y Y | N
+
+Out-of-range values are clamped as by Canonicalize, as in And.
 */
 func (a Tribool) Nor(b Tribool) Tribool {
-	return values[2-max(a, b)]
+	return values[2-max(a, b).Canonicalize()]
 }
 
 /*
@@ -304,6 +677,68 @@ func (a Tribool) NorBool(b bool) Tribool {
 	return a.Nor(FromBool(b))
 }
 
+/*
+Arrow is the Peirce arrow, a ↓ b, and is the same as Nor. Like Nor it is
+functionally complete for Yes and No: every boolean operation can be written
+using only Arrow. It is not complete for three values, since it never makes
+Maybe from Yes and No.
+*/
+func (a Tribool) Arrow(b Tribool) Tribool {
+	return a.Nor(b)
+}
+
+/*
+AndNot is equivalent to a.And(b.Not()), and is the same as NonImply.
+
+		a b | a.AndNot(b)
+		----+------------
+		N N | N
+		N ? | N
+		N Y | N
+		? N | ?
+		? ? | ?
+		? Y | N
+		Y N | Y
+		Y ? | ?
+		Y Y | N
+*/
+func (a Tribool) AndNot(b Tribool) Tribool {
+	return a.And(b.Not())
+}
+
+/*
+AndNotBool is equivalent to a.AndNot(FromBool(b))
+*/
+func (a Tribool) AndNotBool(b bool) Tribool {
+	return a.AndNot(FromBool(b))
+}
+
+/*
+OrNot is equivalent to a.Or(b.Not()), and is the same as ConverseImply.
+
+		a b | a.OrNot(b)
+		----+-----------
+		N N | Y
+		N ? | ?
+		N Y | N
+		? N | Y
+		? ? | ?
+		? Y | ?
+		Y N | Y
+		Y ? | Y
+		Y Y | Y
+*/
+func (a Tribool) OrNot(b Tribool) Tribool {
+	return a.Or(b.Not())
+}
+
+/*
+OrNotBool is equivalent to a.OrNot(FromBool(b))
+*/
+func (a Tribool) OrNotBool(b bool) Tribool {
+	return a.OrNot(FromBool(b))
+}
+
 /*
 Xor implements logical exclusive-or.
 
@@ -359,9 +794,122 @@ func (a Tribool) ImplyBool(b bool) Tribool {
 	return a.Imply(FromBool(b))
 }
 
+/*
+ImpliedBy implements reverse implication, a ⇐ b, which is b.Imply(a). It reads
+naturally as premise.ImpliedBy(conclusion), and is the same as ConverseImply.
+
+		a b | a.ImpliedBy(b)
+		----+---------------
+		N N | Y
+		N ? | ?
+		N Y | N
+		? N | Y
+		? ? | ?
+		? Y | ?
+		Y N | Y
+		Y ? | Y
+		Y Y | Y
+*/
+func (a Tribool) ImpliedBy(b Tribool) Tribool {
+	return b.Imply(a)
+}
+
+/*
+ImpliedByBool is equivalent to a.ImpliedBy(FromBool(b))
+*/
+func (a Tribool) ImpliedByBool(b bool) Tribool {
+	return a.ImpliedBy(FromBool(b))
+}
+
+/*
+NonImply implements logical nonimplication, a ∧ ¬b.
+
+NonImply is the negation of Imply and, like Imply, is not reflexive.
+
+		a b | a.NonImply(b)
+		----+--------------
+		N N | N
+		N ? | N
+		N Y | N
+		? N | ?
+		? ? | ?
+		? Y | N
+		Y N | Y
+		Y ? | ?
+		Y Y | N
+*/
+func (a Tribool) NonImply(b Tribool) Tribool {
+	return a.And(b.Not())
+}
+
+/*
+NonImplyBool is equivalent to a.NonImply(FromBool(b))
+*/
+func (a Tribool) NonImplyBool(b bool) Tribool {
+	return a.NonImply(FromBool(b))
+}
+
+/*
+ConverseImply implements converse implication, b ⇒ a.
+
+		a b | a.ConverseImply(b)
+		----+-------------------
+		N N | Y
+		N ? | ?
+		N Y | N
+		? N | Y
+		? ? | ?
+		? Y | ?
+		Y N | Y
+		Y ? | Y
+		Y Y | Y
+*/
+func (a Tribool) ConverseImply(b Tribool) Tribool {
+	return b.Imply(a)
+}
+
+/*
+ConverseImplyBool is equivalent to a.ConverseImply(FromBool(b))
+*/
+func (a Tribool) ConverseImplyBool(b bool) Tribool {
+	return a.ConverseImply(FromBool(b))
+}
+
+/*
+ConverseNonImply implements converse nonimplication, ¬a ∧ b.
+
+ConverseNonImply is the negation of ConverseImply.
+
+		a b | a.ConverseNonImply(b)
+		----+----------------------
+		N N | N
+		N ? | ?
+		N Y | Y
+		? N | N
+		? ? | ?
+		? Y | ?
+		Y N | N
+		Y ? | N
+		Y Y | N
+*/
+func (a Tribool) ConverseNonImply(b Tribool) Tribool {
+	return b.And(a.Not())
+}
+
+/*
+ConverseNonImplyBool is equivalent to a.ConverseNonImply(FromBool(b))
+*/
+func (a Tribool) ConverseNonImplyBool(b bool) Tribool {
+	return a.ConverseNonImply(FromBool(b))
+}
+
 /*
 Equiv implements logical equivalence.
 
+Equiv is a logical operator, not a comparison: Maybe.Equiv(Maybe) is Maybe
+because two unknowns may or may not agree. Use Same to check whether two
+Tribools hold the same state.
+
 		    | a.Equiv(b)
 		a b | b.Equiv(a)
 		----+------
@@ -386,6 +934,139 @@ func (a Tribool) EquivBool(b bool) Tribool {
 	return a.Equiv(FromBool(b))
 }
 
+/*
+Consensus is the agreement of a and b: the shared state if they are the same,
+or Maybe if they differ.
+
+		    | a.Consensus(b)
+		a b | b.Consensus(a)
+		----+---------------
+		N N | N
+		N ? | ?
+		N Y | ?
+		? N | ?
+		? ? | ?
+		? Y | ?
+		Y N | ?
+		Y ? | ?
+		Y Y | Y
+*/
+func (a Tribool) Consensus(b Tribool) Tribool {
+	if a == b {
+		return a
+	}
+	return maybe
+}
+
+/*
+Merge combines two observations of the same value, preferring the more definite
+one. A known value wins over Maybe, and values that agree are kept. When one is
+Yes and the other No they conflict: the result is Maybe and conflict is true.
+
+		    | a.Merge(b)
+		a b | b.Merge(a)
+		----+-----------------
+		N N | N
+		N ? | N
+		N Y | ? (conflict)
+		? N | N
+		? ? | ?
+		? Y | Y
+		Y N | ? (conflict)
+		Y ? | Y
+		Y Y | Y
+
+Unlike Consensus, which is Maybe unless a and b agree, Merge fills in an
+unknown from a known value.
+*/
+func (a Tribool) Merge(b Tribool) (merged Tribool, conflict bool) {
+	switch {
+	case a == b, b == maybe:
+		return a, false
+	case a == maybe:
+		return b, false
+	}
+	return maybe, true
+}
+
+/*
+Same reports whether a and b hold the same state.
+
+Same is a comparison, not a logical operator: Maybe.Same(Maybe) is true, whereas
+Maybe.Equiv(Maybe) is Maybe.
+
+		    | a.Same(b)  a.Equiv(b)
+		a b | b.Same(a)  b.Equiv(a)
+		----+----------------------
+		N N | true       Y
+		N ? | false      ?
+		N Y | false      N
+		? N | false      ?
+		? ? | true       ?
+		? Y | false      ?
+		Y N | false      N
+		Y ? | false      ?
+		Y Y | true       Y
+*/
+func (a Tribool) Same(b Tribool) bool {
+	return a == b
+}
+
+/*
+IsMaybe reports whether a is Maybe.
+*/
+func (a Tribool) IsMaybe() bool {
+	return a == maybe
+}
+
+/*
+IsValid reports whether a is one of No, Maybe, or Yes. Values outside that
+range can only come from arithmetic or a conversion from an integer, such as
+a corrupt value loaded from disk.
+*/
+func (a Tribool) IsValid() bool {
+	return a >= no && a <= yes
+}
+
+/*
+Canonicalize returns No, Maybe, or Yes for any Tribool, so that a switch over
+those three constants is exhaustive. The synonyms such as True and Off are
+already canonical, since they are the same value as Yes and No.
+
+Out-of-range values, such as from arithmetic or a conversion from int, are
+clamped: anything below No is No, and anything above Yes is Yes.
+*/
+func (a Tribool) Canonicalize() Tribool {
+	switch {
+	case a < no:
+		return no
+	case a > yes:
+		return yes
+	}
+	return values[a]
+}
+
+/*
+Compare orders Tribools as No < Maybe < Yes. It returns -1 if a is before b, 0
+if they are the same state, and +1 if a is after b.
+*/
+func (a Tribool) Compare(b Tribool) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return +1
+	}
+	return 0
+}
+
+/*
+Less reports whether a is before b in the order No < Maybe < Yes.
+*/
+func (a Tribool) Less(b Tribool) bool {
+	return a.Compare(b) < 0
+}
+
 /*
 FromString converts a string to a Tribool.
 
@@ -403,31 +1084,94 @@ FromString converts a string to a Tribool.
 	              no | No
 	             off | No
 	           false | No
+	               ? | Maybe
+	             nil | Maybe
+	            null | Maybe
+	           maybe | Maybe
+	         perhaps | Maybe
+	         unknown | Maybe
+	   indeterminate | Maybe
 	 <anything else> | Maybe
 */
 func FromString(s string) Tribool {
+	a, _ := parse(s)
+	return a
+}
+
+/*
+FromStringOr is like FromString, but returns fallback whenever FromString would
+return Maybe. Only the words for Yes and No are kept, so the explicit words for
+Maybe, such as "maybe" and "unknown", are the fallback too:
+
+	tribool.FromStringOr("", tribool.Yes)        // Yes
+	tribool.FromStringOr("off", tribool.Yes)     // No
+	tribool.FromStringOr("unknown", tribool.Yes) // Yes
+
+FromStringOr(s, Maybe) is the same as FromString(s).
+*/
+func FromStringOr(s string, fallback Tribool) Tribool {
+	if a := FromString(s); a != maybe {
+		return a
+	}
+	return fallback
+}
+
+/*
+ParseStrict is like FromString, but returns an error for any string that
+FromString does not recognize, rather than Maybe. Only the words in the table
+of FromString, including the explicit words for Maybe, are accepted, so
+ParseStrict(a.String()) is a for every state.
+*/
+func ParseStrict(s string) (Tribool, error) {
+	a, ok := parse(s)
+	if !ok {
+		return maybe, fmt.Errorf("tribool: cannot parse %q as a Tribool", s)
+	}
+	return a, nil
+}
+
+/*
+FromBytes converts a byte slice to a Tribool. It accepts the same input as
+FromString, but does not allocate.
+*/
+func FromBytes(b []byte) Tribool {
+	a, _ := parse(b)
+	return a
+}
+
+// parse converts s to a Tribool, and reports whether s is one of the words
+// it recognizes, including the explicit words for Maybe.
+func parse[S string | []byte](s S) (Tribool, bool) {
 	// most flags will be marked as true. This is the fast-path.
-	if s == "true" {
-		return yes
+	if len(s) == 4 && s[0] == 't' && s[1] == 'r' && s[2] == 'u' && s[3] == 'e' {
+		return yes, true
+	}
+	// uppercase is common in logs and from other languages, so it gets a
+	// fast-path too.
+	switch string(s) {
+	case "TRUE":
+		return yes, true
+	case "false", "FALSE":
+		return no, true
 	}
 
 	switch len(s) {
 	case 1:
 		switch s[0] {
 		case 't', 'T', 'y', 'Y', '1':
-			return yes
+			return yes, true
 		case 'f', 'F', 'n', 'N', '0':
-			return no
+			return no, true
 		}
 	case 2:
 		ch0, ch1 := s[0], s[1]
 		switch {
 		case (ch0 == 'o' || ch0 == 'O') &&
 			(ch1 == 'n' || ch1 == 'N'):
-			return yes
+			return yes, true
 		case (ch0 == 'n' || ch0 == 'N') &&
 			(ch1 == 'o' || ch1 == 'O'):
-			return no
+			return no, true
 		}
 	case 3:
 		ch0, ch1, ch2 := s[0], s[1], s[2]
@@ -435,11 +1179,11 @@ func FromString(s string) Tribool {
 		case (ch0 == 'y' || ch0 == 'Y') &&
 			(ch1 == 'e' || ch1 == 'E') &&
 			(ch2 == 's' || ch2 == 'S'):
-			return yes
+			return yes, true
 		case (ch0 == 'o' || ch0 == 'O') &&
 			(ch1 == 'f' || ch1 == 'F') &&
 			(ch2 == 'f' || ch2 == 'F'):
-			return no
+			return no, true
 		}
 	case 4:
 		ch0, ch1, ch2, ch3 := s[0], s[1], s[2], s[3]
@@ -447,7 +1191,7 @@ func FromString(s string) Tribool {
 			(ch1 == 'r' || ch1 == 'R') &&
 			(ch2 == 'u' || ch2 == 'U') &&
 			(ch3 == 'e' || ch3 == 'E') {
-			return yes
+			return yes, true
 		}
 	case 5:
 		ch0, ch1, ch2, ch3, ch4 := s[0], s[1], s[2], s[3], s[4]
@@ -456,32 +1200,130 @@ func FromString(s string) Tribool {
 			(ch2 == 'l' || ch2 == 'L') &&
 			(ch3 == 's' || ch3 == 'S') &&
 			(ch4 == 'e' || ch4 == 'E') {
-			return no
+			return no, true
 		}
 	}
 
-	return maybe
+	return maybe, isMaybeWord(s)
+}
+
+// yesWords and noWords are the words parsed as Yes and No, in lowercase. They
+// must be kept in sync with parse.
+var (
+	yesWords = [...]string{"true", "t", "yes", "y", "1", "on"}
+	noWords  = [...]string{"false", "f", "no", "n", "0", "off"}
+)
+
+/*
+AcceptedTokens returns the words that FromString parses as Yes and as No, in
+lowercase, such as for help text. Parsing ignores case, so "TRUE" and "True"
+are accepted as well. The slices are new on each call and may be modified.
+*/
+func AcceptedTokens() (truthy, falsy []string) {
+	return append([]string(nil), yesWords[:]...), append([]string(nil), noWords[:]...)
+}
+
+// maybeWords are the words parsed as an explicit Maybe, in lowercase.
+var maybeWords = [...]string{"?", "nil", "null", "maybe", "perhaps", "unknown", "indeterminate"}
+
+func isMaybeWord[S string | []byte](s S) bool {
+	for _, word := range maybeWords {
+		if equalLower(s, word) {
+			return true
+		}
+	}
+	return false
+}
+
+// equalLower reports whether s equals lower, ignoring ASCII case. The lower
+// string must be in lowercase.
+func equalLower[S string | []byte](s S, lower string) bool {
+	if len(s) != len(lower) {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		ch := s[i]
+		if 'A' <= ch && ch <= 'Z' {
+			ch += 'a' - 'A'
+		}
+		if ch != lower[i] {
+			return false
+		}
+	}
+	return true
+}
+
+/*
+Set parses s with FromString and stores the result. Together with String and
+Type, this lets a *Tribool be used as a flag.Value or a pflag.Value.
+*/
+func (a *Tribool) Set(s string) error {
+	*a = FromString(s)
+	return nil
+}
+
+/*
+Type names the flag type, as required by pflag.Value.
+*/
+func (a *Tribool) Type() string {
+	return "tribool"
 }
 
 // MarshalJSON marshals tribools to strings, using the Tribool.String() method.
+//
+// Beware that a Tribool field tagged with omitempty is omitted when it is `No`,
+// since `No` is the zero value. See `Optional` to omit only `Maybe`.
 func (a Tribool) MarshalJSON() ([]byte, error) {
 	return json.Marshal(a.String())
 }
 
-// UnmarshalJSON supports unmarshalling from a json string (using `FromString()`), a json
-// boolean (using `FromBool()`), and treats anything else as `maybe`.
+// UnmarshalJSON supports unmarshalling from a json null (as `Maybe`), a json
+// boolean (using `FromBool()`), a json number (using `fromNumber()`), and a json
+// string (using `FromString()`). Anything else is an error.
+//
+// The forms are tried in that order: null, bool, number, string. The literals
+// are checked first because they are the cheapest and most common, and a value
+// is only treated as a string when it is quoted, so the form of the json alone
+// decides how it is read. Surrounding whitespace is ignored. A quoted number is
+// a string, so "1" and "0" are parsed by `FromString()` like any other string,
+// while "2" and "123" are `Maybe`; the unquoted numbers 2 and 123 are `Yes`.
+//
+// Each element of a json array is decoded on its own, so an array may mix the
+// forms: `[true, null, false, "maybe"]` decodes to a `[]Tribool` of Yes, Maybe,
+// No, Maybe, and a null element does not fail the whole array.
 func (a *Tribool) UnmarshalJSON(data []byte) error {
 	if a == nil {
 		return errors.New("tribool.TriBool: UnmarshalJSON on nil pointer")
 	}
+	data = bytes.TrimSpace(data)
+	var n json.Number
 	var s string
-	var b bool
-	if err := json.Unmarshal(data, &s); err == nil {
+	if bytes.Equal(data, jsonNull) {
+		*a = Maybe
+	} else if bytes.Equal(data, jsonTrue) {
+		*a = Yes
+	} else if bytes.Equal(data, jsonFalse) {
+		*a = No
+	} else if err := json.Unmarshal(data, &n); err == nil && data[0] != '"' {
+		// A number too large for a float64 is ±Inf, which is still Yes.
+		f, _ := n.Float64()
+		*a = fromNumber(f)
+	} else if err := json.Unmarshal(data, &s); err == nil {
 		*a = FromString(s)
-	} else if err := json.Unmarshal(data, &b); err == nil {
-		*a = FromBool(b)
 	} else {
-		*a = Maybe
+		return fmt.Errorf("tribool.TriBool: cannot unmarshal %s into a Tribool", data)
 	}
 	return nil
 }
+
+// fromNumber converts zero to `No` and any other whole number to `Yes`. Fractions
+// and NaN are `Maybe`.
+func fromNumber(f float64) Tribool {
+	switch {
+	case f == 0:
+		return no
+	case f != math.Trunc(f):
+		return maybe
+	}
+	return yes
+}
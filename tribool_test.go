@@ -1,8 +1,14 @@
 package tribool
 
 import (
+	"encoding"
 	"encoding/json"
+	"errors"
+	"flag"
 	"fmt"
+	"math"
+	"math/rand"
+	"strings"
 	"testing"
 )
 
@@ -49,10 +55,10 @@ func TestTribool_Ops1(t *testing.T) {
 			return a.Not()
 		},
 		"upgrade": func(a Tribool) Tribool {
-			return FromBool(a.WithMaybeAsTrue())
+			return a.Upgrade()
 		},
 		"downgrade": func(a Tribool) Tribool {
-			return FromBool(a.WithMaybeAsFalse())
+			return a.Downgrade()
 		},
 	}
 
@@ -63,6 +69,126 @@ func TestTribool_Ops1(t *testing.T) {
 		}
 	}
 }
+
+func TestTribool_unaryTables(t *testing.T) {
+	for _, a := range values {
+		if expected := values[2-a]; a.Not() != expected {
+			t.Errorf("%s.Not() => %s instead of the expected %s", a, a.Not(), expected)
+		}
+		if expected := FromBool(a.WithMaybeAsTrue()); a.Upgrade() != expected {
+			t.Errorf("%s.Upgrade() => %s instead of the expected %s", a, a.Upgrade(), expected)
+		}
+		if expected := FromBool(a.WithMaybeAsFalse()); a.Downgrade() != expected {
+			t.Errorf("%s.Downgrade() => %s instead of the expected %s", a, a.Downgrade(), expected)
+		}
+	}
+}
+
+var benchTribool Tribool
+
+func BenchmarkTribool_Not(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		benchTribool = values[i%3].Not()
+	}
+}
+
+func BenchmarkTribool_Upgrade(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		benchTribool = values[i%3].Upgrade()
+	}
+}
+
+func BenchmarkTribool_Downgrade(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		benchTribool = values[i%3].Downgrade()
+	}
+}
+
+func TestTribool_WithMaybeAs(t *testing.T) {
+	N, x, Y := No, Maybe, Yes
+	table := []struct {
+		a        Tribool
+		b        bool
+		expected bool
+	}{
+		{N, false, false},
+		{x, false, false},
+		{Y, false, true},
+		{N, true, false},
+		{x, true, true},
+		{Y, true, true},
+	}
+	for _, test := range table {
+		if actual := test.a.WithMaybeAs(test.b); actual != test.expected {
+			t.Errorf("%s.WithMaybeAs(%v) => %v instead of the expected %v", test.a, test.b, actual, test.expected)
+		}
+	}
+
+	for _, a := range values {
+		if a.WithMaybeAs(true) != a.WithMaybeAsTrue() || a.WithMaybeAs(false) != a.WithMaybeAsFalse() {
+			t.Errorf("%s.WithMaybeAs disagrees with WithMaybeAsTrue or WithMaybeAsFalse", a)
+		}
+		if MaybeAsTrue(a) != a.WithMaybeAsTrue() || MaybeAsFalse(a) != a.WithMaybeAsFalse() {
+			t.Errorf("MaybeAsTrue(%s) or MaybeAsFalse(%s) disagrees with its method", a, a)
+		}
+	}
+}
+
+func TestTribool_Weaken_Strengthen(t *testing.T) {
+	N, x, Y := No, Maybe, Yes
+	table := []struct {
+		a, toward  Tribool
+		strengthen Tribool
+	}{
+		{N, N, N}, {N, x, N}, {N, Y, N},
+		{x, N, N}, {x, x, x}, {x, Y, Y},
+		{Y, N, Y}, {Y, x, Y}, {Y, Y, Y},
+	}
+	for _, test := range table {
+		if actual := test.a.Strengthen(test.toward); actual != test.strengthen {
+			t.Errorf("%s.Strengthen(%s) => %s instead of the expected %s", test.a, test.toward, actual, test.strengthen)
+		}
+	}
+
+	for _, a := range values {
+		if actual := a.Weaken(); actual != Maybe {
+			t.Errorf("%s.Weaken() => %s instead of the expected maybe", a, actual)
+		}
+		if a.Weaken().Weaken() != a.Weaken() {
+			t.Errorf("%s.Weaken() is not idempotent", a)
+		}
+		if a.Strengthen(Yes) != a.Upgrade() || a.Strengthen(No) != a.Downgrade() {
+			t.Errorf("%s.Strengthen disagrees with Upgrade or Downgrade", a)
+		}
+	}
+}
+
+func TestTribool_MustBool(t *testing.T) {
+	for _, test := range []struct {
+		a        Tribool
+		expected bool
+	}{{Yes, true}, {No, false}} {
+		actual, err := test.a.MustBool()
+		if err != nil || actual != test.expected {
+			t.Errorf("%s.MustBool() => %v, %v instead of the expected %v", test.a, actual, err, test.expected)
+		}
+		if actual := test.a.MustBoolStrict(); actual != test.expected {
+			t.Errorf("%s.MustBoolStrict() => %v instead of the expected %v", test.a, actual, test.expected)
+		}
+	}
+
+	if actual, err := Maybe.MustBool(); !errors.Is(err, ErrMaybe) {
+		t.Errorf("maybe.MustBool() => %v, %v instead of ErrMaybe", actual, err)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("maybe.MustBoolStrict() did not panic")
+		}
+	}()
+	Maybe.MustBoolStrict()
+}
+
 func TestTribool_Ops2(t *testing.T) {
 	N, x, Y := No, Maybe, Yes
 	table := []struct {
@@ -139,6 +265,76 @@ func TestTribool_Ops2(t *testing.T) {
 		{Y, N, "implies", N},
 		{Y, x, "implies", x},
 		{Y, Y, "implies", Y},
+
+		{N, N, "consensus", N},
+		{N, x, "consensus", x},
+		{N, Y, "consensus", x},
+		{x, N, "consensus", x},
+		{x, x, "consensus", x},
+		{x, Y, "consensus", x},
+		{Y, N, "consensus", x},
+		{Y, x, "consensus", x},
+		{Y, Y, "consensus", Y},
+
+		{N, N, "andnot", N},
+		{N, x, "andnot", N},
+		{N, Y, "andnot", N},
+		{x, N, "andnot", x},
+		{x, x, "andnot", x},
+		{x, Y, "andnot", N},
+		{Y, N, "andnot", Y},
+		{Y, x, "andnot", x},
+		{Y, Y, "andnot", N},
+
+		{N, N, "ornot", Y},
+		{N, x, "ornot", x},
+		{N, Y, "ornot", N},
+		{x, N, "ornot", Y},
+		{x, x, "ornot", x},
+		{x, Y, "ornot", x},
+		{Y, N, "ornot", Y},
+		{Y, x, "ornot", Y},
+		{Y, Y, "ornot", Y},
+
+		{N, N, "impliedby", Y},
+		{N, x, "impliedby", x},
+		{N, Y, "impliedby", N},
+		{x, N, "impliedby", Y},
+		{x, x, "impliedby", x},
+		{x, Y, "impliedby", x},
+		{Y, N, "impliedby", Y},
+		{Y, x, "impliedby", Y},
+		{Y, Y, "impliedby", Y},
+
+		{N, N, "nonimplies", N},
+		{N, x, "nonimplies", N},
+		{N, Y, "nonimplies", N},
+		{x, N, "nonimplies", x},
+		{x, x, "nonimplies", x},
+		{x, Y, "nonimplies", N},
+		{Y, N, "nonimplies", Y},
+		{Y, x, "nonimplies", x},
+		{Y, Y, "nonimplies", N},
+
+		{N, N, "converseimplies", Y},
+		{N, x, "converseimplies", x},
+		{N, Y, "converseimplies", N},
+		{x, N, "converseimplies", Y},
+		{x, x, "converseimplies", x},
+		{x, Y, "converseimplies", x},
+		{Y, N, "converseimplies", Y},
+		{Y, x, "converseimplies", Y},
+		{Y, Y, "converseimplies", Y},
+
+		{N, N, "conversenonimplies", N},
+		{N, x, "conversenonimplies", x},
+		{N, Y, "conversenonimplies", Y},
+		{x, N, "conversenonimplies", N},
+		{x, x, "conversenonimplies", x},
+		{x, Y, "conversenonimplies", x},
+		{Y, N, "conversenonimplies", N},
+		{Y, x, "conversenonimplies", N},
+		{Y, Y, "conversenonimplies", N},
 	}
 
 	op2 := map[string]func(a, b Tribool) Tribool{
@@ -163,6 +359,27 @@ func TestTribool_Ops2(t *testing.T) {
 		"implies": func(a, b Tribool) Tribool {
 			return a.Imply(b)
 		},
+		"consensus": func(a, b Tribool) Tribool {
+			return a.Consensus(b)
+		},
+		"andnot": func(a, b Tribool) Tribool {
+			return a.AndNot(b)
+		},
+		"ornot": func(a, b Tribool) Tribool {
+			return a.OrNot(b)
+		},
+		"impliedby": func(a, b Tribool) Tribool {
+			return a.ImpliedBy(b)
+		},
+		"nonimplies": func(a, b Tribool) Tribool {
+			return a.NonImply(b)
+		},
+		"converseimplies": func(a, b Tribool) Tribool {
+			return a.ConverseImply(b)
+		},
+		"conversenonimplies": func(a, b Tribool) Tribool {
+			return a.ConverseNonImply(b)
+		},
 	}
 
 	for _, test := range table {
@@ -225,11 +442,15 @@ func TestTribool_parse(t *testing.T) {
 		{"fALSE", No}, {"FALSE", No},
 	}
 	errf := "FromString(%s) => %s instead of the expected %s"
+	errbf := "FromBytes(%s) => %s instead of the expected %s"
 	for _, test := range table {
 		actual := FromString(test.raw)
 		if actual != test.expected {
 			t.Errorf(errf, test.raw, actual, test.expected)
 		}
+		if actual := FromBytes([]byte(test.raw)); actual != test.expected {
+			t.Errorf(errbf, test.raw, actual, test.expected)
+		}
 		// replace each character with an x, it should result in a maybe
 		for i := range test.raw {
 			bs := []byte(test.raw)
@@ -238,12 +459,173 @@ func TestTribool_parse(t *testing.T) {
 			if actual != Maybe {
 				t.Errorf(errf, test.raw, actual, Maybe)
 			}
+			if actual := FromBytes(bs); actual != Maybe {
+				t.Errorf(errbf, bs, actual, Maybe)
+			}
 		}
 	}
 
 	if FromString("") != Maybe {
 		t.Errorf(errf, "", FromString(""), Maybe)
 	}
+	if FromBytes(nil) != Maybe {
+		t.Errorf(errbf, "", FromBytes(nil), Maybe)
+	}
+}
+
+func TestTribool_parseMaybeWords(t *testing.T) {
+	for _, raw := range []string{
+		"?", "nil", "NIL", "null", "Null", "maybe", "MAYBE", "mAyBe",
+		"perhaps", "Perhaps", "unknown", "UNKNOWN", "indeterminate", "Indeterminate",
+	} {
+		if actual := FromString(raw); actual != Maybe {
+			t.Errorf("FromString(%s) => %s instead of the expected maybe", raw, actual)
+		}
+		if actual, ok := parse(raw); actual != Maybe || !ok {
+			t.Errorf("parse(%s) => %s, %v instead of the expected maybe, true", raw, actual, ok)
+		}
+		if actual, ok := parse([]byte(raw)); actual != Maybe || !ok {
+			t.Errorf("parse([]byte(%s)) => %s, %v instead of the expected maybe, true", raw, actual, ok)
+		}
+	}
+
+	for _, raw := range []string{"", "??", "maybee", "mayb", "unknow", "nul", "n/a", "huh?"} {
+		if actual, ok := parse(raw); actual != Maybe || ok {
+			t.Errorf("parse(%s) => %s, %v instead of the expected maybe, false", raw, actual, ok)
+		}
+	}
+
+	for _, raw := range []string{"t", "yes", "TRUE", "f", "off", "False"} {
+		if _, ok := parse(raw); !ok {
+			t.Errorf("parse(%s) did not recognize a known word", raw)
+		}
+	}
+}
+
+func TestFromStringOr(t *testing.T) {
+	N, x, Y := No, Maybe, Yes
+	table := []struct {
+		raw              string
+		orYes, orNo, orX Tribool
+	}{
+		{"yes", Y, Y, Y},
+		{"TRUE", Y, Y, Y},
+		{"off", N, N, N},
+		{"0", N, N, N},
+		{"maybe", Y, N, x},
+		{"Unknown", Y, N, x},
+		{"", Y, N, x},
+		{"asdf", Y, N, x},
+	}
+	for _, test := range table {
+		for _, c := range []struct{ fallback, expected Tribool }{
+			{Y, test.orYes}, {N, test.orNo}, {x, test.orX},
+		} {
+			if actual := FromStringOr(test.raw, c.fallback); actual != c.expected {
+				t.Errorf("FromStringOr(%q, %s) => %s instead of the expected %s", test.raw, c.fallback, actual, c.expected)
+			}
+		}
+		if FromStringOr(test.raw, x) != FromString(test.raw) {
+			t.Errorf("FromStringOr(%q, maybe) differs from FromString", test.raw)
+		}
+	}
+}
+
+func TestParseStrict(t *testing.T) {
+	for _, a := range values {
+		for _, raw := range []string{a.String(), strings.ToUpper(a.String()), a.Title()} {
+			actual, err := ParseStrict(raw)
+			if err != nil {
+				t.Errorf("ParseStrict(%q) returned error: %v", raw, err)
+			} else if actual != a {
+				t.Errorf("ParseStrict(%q) => %s instead of the expected %s", raw, actual, a)
+			}
+		}
+	}
+
+	table := []struct {
+		raw      string
+		expected Tribool
+	}{
+		{"t", Yes}, {"ON", Yes}, {"1", Yes},
+		{"f", No}, {"Off", No}, {"0", No},
+		{"?", Maybe}, {"unknown", Maybe}, {"NULL", Maybe},
+	}
+	for _, test := range table {
+		if actual, err := ParseStrict(test.raw); err != nil || actual != test.expected {
+			t.Errorf("ParseStrict(%q) => %s, %v instead of the expected %s", test.raw, actual, err, test.expected)
+		}
+	}
+
+	for _, raw := range []string{"", "asdf", "yes ", "2", "maybee"} {
+		if actual, err := ParseStrict(raw); err == nil {
+			t.Errorf("ParseStrict(%q) => %s instead of an error", raw, actual)
+		}
+	}
+}
+
+func TestAcceptedTokens(t *testing.T) {
+	truthy, falsy := AcceptedTokens()
+	if len(truthy) != 6 || len(falsy) != 6 {
+		t.Errorf("AcceptedTokens() => %v, %v instead of six words each", truthy, falsy)
+	}
+	table := []struct {
+		words    []string
+		expected Tribool
+	}{
+		{truthy, Yes},
+		{falsy, No},
+	}
+	for _, test := range table {
+		for _, word := range test.words {
+			for _, raw := range []string{word, strings.ToUpper(word)} {
+				if actual := FromString(raw); actual != test.expected {
+					t.Errorf("FromString(%s) => %s instead of the expected %s", raw, actual, test.expected)
+				}
+			}
+		}
+	}
+
+	truthy[0] = "changed"
+	if again, _ := AcceptedTokens(); again[0] == "changed" {
+		t.Errorf("modifying the result of AcceptedTokens() changed the package state")
+	}
+}
+
+// benchToken is longer than the 32 bytes the compiler can convert to a string
+// on the stack, so string(benchToken) allocates where FromBytes does not.
+var benchToken = []byte("not-a-tribool-but-longer-than-32-bytes")
+
+func BenchmarkFromString(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchTribool = FromString(string(benchToken))
+	}
+}
+
+func BenchmarkFromString_case(b *testing.B) {
+	table := []struct {
+		name string
+		raw  []string
+	}{
+		{"lower", []string{"true", "false"}},
+		{"upper", []string{"TRUE", "FALSE"}},
+		{"mixed", []string{"True", "False"}},
+	}
+	for _, test := range table {
+		b.Run(test.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				benchTribool = FromString(test.raw[i&1])
+			}
+		})
+	}
+}
+
+func BenchmarkFromBytes(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchTribool = FromBytes(benchToken)
+	}
 }
 
 func TestTribool_UnmarshalJSON(t *testing.T) {
@@ -255,8 +637,12 @@ func TestTribool_UnmarshalJSON(t *testing.T) {
 		{`"true"`, True}, {`"false"`, False},
 		{`"yes"`, True}, {`"no"`, False},
 		{`"maybe"`, Maybe}, {`""`, Maybe},
-		{`"asdf"`, Maybe}, {`2`, Maybe},
-		{`{"an":"object"}`, Maybe}, {`null`, Maybe},
+		{`"asdf"`, Maybe}, {`null`, Maybe},
+		{`1`, True}, {`0`, False},
+		{`2`, True}, {`-1`, True},
+		{`0.0`, False}, {`1e3`, True},
+		{`1.5`, Maybe}, {`-0.5`, Maybe},
+		{`-0`, False}, {`1.0`, True},
 	}
 	for _, test := range table {
 		var tri Tribool
@@ -271,6 +657,103 @@ func TestTribool_UnmarshalJSON(t *testing.T) {
 	}
 }
 
+func TestTribool_UnmarshalJSON_mixedArray(t *testing.T) {
+	N, x, Y := No, Maybe, Yes
+	table := []struct {
+		jsonString string
+		expected   []Tribool
+	}{
+		{`[true, null, false, "maybe"]`, []Tribool{Y, x, N, x}},
+		{`[null, null]`, []Tribool{x, x}},
+		{`["yes", 0, true, null, 1.5, "off"]`, []Tribool{Y, N, Y, x, x, N}},
+		{`[]`, []Tribool{}},
+	}
+	for _, test := range table {
+		var actual []Tribool
+		if err := json.Unmarshal([]byte(test.jsonString), &actual); err != nil {
+			t.Errorf("Unmarshalling %s returned error: %v", test.jsonString, err)
+			continue
+		}
+		assertSlice(t, "json.Unmarshal("+test.jsonString+")", actual, test.expected)
+	}
+
+	var actual []Tribool
+	if err := json.Unmarshal([]byte(`[true, {}, false]`), &actual); err == nil {
+		t.Errorf("json.Unmarshal of an array holding an object => %v instead of an error", actual)
+	}
+}
+
+func TestTribool_UnmarshalJSON_ambiguous(t *testing.T) {
+	table := []struct {
+		jsonString string
+		expected   Tribool
+	}{
+		// the same value as a bool, a number, and a string
+		{`true`, Yes}, {`1`, Yes}, {`"1"`, Yes}, {`"true"`, Yes},
+		{`false`, No}, {`0`, No}, {`"0"`, No}, {`"false"`, No},
+
+		// quoted numbers other than 0 and 1 are strings FromString does not know
+		{`123`, Yes}, {`"123"`, Maybe},
+		{`2`, Yes}, {`"2"`, Maybe},
+		{`0.0`, No}, {`"0.0"`, Maybe},
+
+		// numbers too large for a float64 are still nonzero
+		{`1e400`, Yes}, {`-1e400`, Yes}, {`"1e400"`, Maybe},
+
+		// quoted literals are strings
+		{`null`, Maybe}, {`"null"`, Maybe},
+	}
+	for _, test := range table {
+		var tri Tribool
+		if err := json.Unmarshal([]byte(test.jsonString), &tri); err != nil {
+			t.Errorf("Unmarshalling %s returned error: %v", test.jsonString, err)
+		} else if tri != test.expected {
+			t.Errorf("json.Unmarshal(%s) => %v instead of the expected %v", test.jsonString, tri, test.expected)
+		}
+	}
+}
+
+func TestTribool_UnmarshalJSON_whitespace(t *testing.T) {
+	table := []struct {
+		jsonString string
+		expected   Tribool
+	}{
+		{` true `, True}, {"\tfalse\n", False},
+		{` null `, Maybe}, {"\r\n0 ", False},
+		{`"yes"`, True}, {` "no" `, False},
+		{`"1"`, True}, {`"0"`, False},
+		{`"2"`, Maybe}, {`" 1"`, Maybe},
+	}
+	for _, test := range table {
+		tri := Tribool(-1)
+		err := tri.UnmarshalJSON([]byte(test.jsonString))
+		if err != nil {
+			t.Errorf("UnmarshalJSON(%q) returned error: %v", test.jsonString, err)
+		} else if tri != test.expected {
+			t.Errorf("UnmarshalJSON(%q) => %v instead of the expected %v", test.jsonString, tri, test.expected)
+		}
+	}
+}
+
+func TestTribool_UnmarshalJSON_invalid(t *testing.T) {
+	table := []string{
+		`[]`, `[true]`, `["yes", "no"]`,
+		`{}`, `{"an":"object"}`,
+	}
+	for _, jsonString := range table {
+		tri := Yes
+		err := json.Unmarshal([]byte(jsonString), &tri)
+		if err == nil {
+			t.Errorf("json.Unmarshal(%s) => %v instead of an error", jsonString, tri)
+		}
+	}
+
+	var nilTri *Tribool
+	if err := nilTri.UnmarshalJSON([]byte(`true`)); err == nil {
+		t.Errorf("UnmarshalJSON on a nil pointer should return an error")
+	}
+}
+
 func TestTribool_MarshalJSON(t *testing.T) {
 	for _, tri := range values {
 		jsonBytes, err := json.Marshal(tri)
@@ -284,3 +767,461 @@ func TestTribool_MarshalJSON(t *testing.T) {
 		}
 	}
 }
+
+func TestTribool_GoString(t *testing.T) {
+	table := []struct {
+		tri      Tribool
+		expected string
+	}{
+		{Yes, "tribool.Yes"},
+		{Maybe, "tribool.Maybe"},
+		{No, "tribool.No"},
+		{Tribool(7), "tribool.Tribool(7)"},
+		{Tribool(-1), "tribool.Tribool(-1)"},
+	}
+	for _, test := range table {
+		actual := fmt.Sprintf("%#v", test.tri)
+		if actual != test.expected {
+			t.Errorf("%%#v of %d => %s instead of the expected %s", int(test.tri), actual, test.expected)
+		}
+	}
+
+	node := struct{ IsActive Tribool }{Maybe}
+	expected := "struct { IsActive tribool.Tribool }{IsActive:tribool.Maybe}"
+	if actual := fmt.Sprintf("%#v", node); actual != expected {
+		t.Errorf("%%#v of a struct => %s instead of the expected %s", actual, expected)
+	}
+}
+
+func TestTribool_Merge(t *testing.T) {
+	N, x, Y := No, Maybe, Yes
+	table := []struct {
+		a, b     Tribool
+		expected Tribool
+		conflict bool
+	}{
+		{N, N, N, false},
+		{N, x, N, false},
+		{N, Y, x, true},
+		{x, N, N, false},
+		{x, x, x, false},
+		{x, Y, Y, false},
+		{Y, N, x, true},
+		{Y, x, Y, false},
+		{Y, Y, Y, false},
+	}
+	for _, test := range table {
+		actual, conflict := test.a.Merge(test.b)
+		if actual != test.expected || conflict != test.conflict {
+			t.Errorf("%s.Merge(%s) => %s, %v instead of the expected %s, %v", test.a, test.b, actual, conflict, test.expected, test.conflict)
+		}
+	}
+}
+
+func TestTribool_Compare(t *testing.T) {
+	N, x, Y := No, Maybe, Yes
+	table := []struct {
+		a, b     Tribool
+		expected int
+	}{
+		{N, N, 0}, {N, x, -1}, {N, Y, -1},
+		{x, N, 1}, {x, x, 0}, {x, Y, -1},
+		{Y, N, 1}, {Y, x, 1}, {Y, Y, 0},
+	}
+	for _, test := range table {
+		if actual := test.a.Compare(test.b); actual != test.expected {
+			t.Errorf("%s.Compare(%s) => %d instead of the expected %d", test.a, test.b, actual, test.expected)
+		}
+		if actual := test.a.Less(test.b); actual != (test.expected < 0) {
+			t.Errorf("%s.Less(%s) => %v instead of the expected %v", test.a, test.b, actual, test.expected < 0)
+		}
+	}
+}
+
+func TestTribool_Same(t *testing.T) {
+	for _, a := range values {
+		for _, b := range values {
+			expected := a == b
+			if actual := a.Same(b); actual != expected {
+				t.Errorf("%s.Same(%s) => %v instead of the expected %v", a, b, actual, expected)
+			}
+		}
+	}
+
+	// The Maybe/Maybe cell is where Same and Equiv differ
+	if !Maybe.Same(Maybe) {
+		t.Errorf("maybe.Same(maybe) => false instead of the expected true")
+	}
+	if actual := Maybe.Equiv(Maybe); actual != Maybe {
+		t.Errorf("maybe.Equiv(maybe) => %s instead of the expected maybe", actual)
+	}
+}
+
+func TestTribool_AppendText(t *testing.T) {
+	var _ encoding.TextAppender = Tribool(0)
+
+	table := []struct {
+		tri          Tribool
+		text, symbol string
+	}{
+		{Yes, "yes", "Y"},
+		{Maybe, "maybe", "?"},
+		{No, "no", "N"},
+	}
+	for _, test := range table {
+		if actual := test.tri.Symbol(); actual != test.symbol {
+			t.Errorf("%s.Symbol() => %s instead of the expected %s", test.tri, actual, test.symbol)
+		}
+		if actual := FromSymbol(test.symbol); actual != test.tri {
+			t.Errorf("FromSymbol(%s) => %s instead of the expected %s", test.symbol, actual, test.tri)
+		}
+		if actual, _ := test.tri.MarshalText(); string(actual) != test.text {
+			t.Errorf("%s.MarshalText() => %s instead of the expected %s", test.tri, actual, test.text)
+		}
+		var parsed Tribool
+		if err := parsed.UnmarshalText([]byte(test.text)); err != nil || parsed != test.tri {
+			t.Errorf("UnmarshalText(%s) => %s, %v instead of the expected %s", test.text, parsed, err, test.tri)
+		}
+		if actual, err := test.tri.AppendText([]byte("flag=")); err != nil || string(actual) != "flag="+test.text {
+			t.Errorf("%s.AppendText(flag=) => %s, %v instead of the expected flag=%s", test.tri, actual, err, test.text)
+		}
+		text, _ := test.tri.MarshalText()
+		if actual, _ := test.tri.AppendText(nil); string(actual) != string(text) {
+			t.Errorf("%s.AppendText(nil) => %s instead of MarshalText() => %s", test.tri, actual, text)
+		}
+		if actual := test.tri.AppendSymbol([]byte("flag=")); string(actual) != "flag="+test.symbol {
+			t.Errorf("%s.AppendSymbol(flag=) => %s instead of the expected flag=%s", test.tri, actual, test.symbol)
+		}
+	}
+
+	var buf []byte
+	for _, tri := range []Tribool{Yes, No, Maybe} {
+		buf = tri.AppendSymbol(buf)
+	}
+	if string(buf) != "YN?" {
+		t.Errorf("appending symbols => %s instead of the expected YN?", buf)
+	}
+}
+
+var benchBuf []byte
+
+func BenchmarkTribool_MarshalText(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchBuf, _ = values[i%3].MarshalText()
+	}
+}
+
+func BenchmarkTribool_AppendText(b *testing.B) {
+	b.ReportAllocs()
+	benchBuf = make([]byte, 0, 8)
+	for i := 0; i < b.N; i++ {
+		benchBuf, _ = values[i%3].AppendText(benchBuf[:0])
+	}
+}
+
+func TestTribool_Set(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	feature := Maybe
+	fs.Var(&feature, "feature", "enable the feature")
+
+	if err := fs.Parse([]string{"-feature=off"}); err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	if feature != No {
+		t.Errorf("-feature=off => %s instead of the expected no", feature)
+	}
+	if typ := feature.Type(); typ != "tribool" {
+		t.Errorf("Type() => %s instead of the expected tribool", typ)
+	}
+}
+
+func TestTribool_ImpliedBy(t *testing.T) {
+	for _, a := range values {
+		for _, b := range values {
+			if a.ImpliedBy(b) != b.Imply(a) {
+				t.Errorf("%s.ImpliedBy(%s) => %s instead of %s.Imply(%s) => %s", a, b, a.ImpliedBy(b), b, a, b.Imply(a))
+			}
+		}
+		for _, b := range []bool{false, true} {
+			if a.ImpliedByBool(b) != a.ImpliedBy(FromBool(b)) {
+				t.Errorf("%s.ImpliedByBool(%v) => %s instead of %s", a, b, a.ImpliedByBool(b), a.ImpliedBy(FromBool(b)))
+			}
+		}
+	}
+}
+
+func TestValues(t *testing.T) {
+	vs := Values()
+	if len(vs) != 3 || vs[0] != No || vs[1] != Maybe || vs[2] != Yes {
+		t.Errorf("Values() => %v instead of the expected [no maybe yes]", vs)
+	}
+
+	vs[0] = Yes
+	if Values()[0] != No {
+		t.Errorf("modifying the result of Values() changed the package state")
+	}
+}
+
+func TestNew(t *testing.T) {
+	for n, expected := range []Tribool{No, Maybe, Yes} {
+		actual, err := New(n)
+		if err != nil || actual != expected {
+			t.Errorf("New(%d) => %s, %v instead of the expected %s", n, actual, err, expected)
+		}
+		if actual := MustNew(n); actual != expected {
+			t.Errorf("MustNew(%d) => %s instead of the expected %s", n, actual, expected)
+		}
+	}
+
+	for _, n := range []int{-1, 3, 7, math.MaxInt} {
+		if actual, err := New(n); err == nil {
+			t.Errorf("New(%d) => %s instead of an error", n, actual)
+		}
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("MustNew(%d) did not panic", n)
+				}
+			}()
+			MustNew(n)
+		}()
+	}
+}
+
+func TestStates(t *testing.T) {
+	var actual []Tribool
+	for a := range States() {
+		actual = append(actual, a)
+	}
+	assertSlice(t, "States()", actual, []Tribool{No, Maybe, Yes})
+
+	actual = actual[:0]
+	for a := range States() {
+		actual = append(actual, a)
+		if a == Maybe {
+			break
+		}
+	}
+	assertSlice(t, "States() with break", actual, []Tribool{No, Maybe})
+}
+
+func TestTribool_Resolve(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		if !Yes.Resolve(r, 0) {
+			t.Fatalf("yes.Resolve(r, 0) => false instead of the expected true")
+		}
+		if No.Resolve(r, 1) {
+			t.Fatalf("no.Resolve(r, 1) => true instead of the expected false")
+		}
+		if !Maybe.Resolve(r, 1) {
+			t.Fatalf("maybe.Resolve(r, 1) => false instead of the expected true")
+		}
+		if Maybe.Resolve(r, 0) {
+			t.Fatalf("maybe.Resolve(r, 0) => true instead of the expected false")
+		}
+		Maybe.Resolve(nil, 0.5)
+	}
+
+	// the same seed gives the same trials
+	sample := func(seed int64) []bool {
+		r := rand.New(rand.NewSource(seed))
+		trials := make([]bool, 64)
+		for i := range trials {
+			trials[i] = Maybe.Resolve(r, 0.5)
+		}
+		return trials
+	}
+	first, second := sample(42), sample(42)
+	trues := 0
+	for i := range first {
+		if first[i] != second[i] {
+			t.Fatalf("Resolve with the same seed gave different trials")
+		}
+		if first[i] {
+			trues++
+		}
+	}
+	if trues == 0 || trues == len(first) {
+		t.Errorf("maybe.Resolve(r, 0.5) gave %d of %d true", trues, len(first))
+	}
+}
+
+func TestTribool_displayStrings(t *testing.T) {
+	table := []struct {
+		tri                   Tribool
+		str, title, trueFalse string
+	}{
+		{Yes, "yes", "Yes", "True"},
+		{Maybe, "maybe", "Maybe", "Unknown"},
+		{No, "no", "No", "False"},
+	}
+	for _, test := range table {
+		if actual := test.tri.String(); actual != test.str {
+			t.Errorf("%s.String() => %s instead of the expected %s", test.tri, actual, test.str)
+		}
+		if actual := test.tri.Title(); actual != test.title {
+			t.Errorf("%s.Title() => %s instead of the expected %s", test.tri, actual, test.title)
+		}
+		if actual := test.tri.TrueFalse(); actual != test.trueFalse {
+			t.Errorf("%s.TrueFalse() => %s instead of the expected %s", test.tri, actual, test.trueFalse)
+		}
+	}
+}
+
+func TestFromNumber(t *testing.T) {
+	table := []struct {
+		f        float64
+		expected Tribool
+	}{
+		{0, No}, {math.Copysign(0, -1), No},
+		{1, Yes}, {2, Yes}, {-1, Yes}, {1e300, Yes},
+		{math.Inf(1), Yes}, {math.Inf(-1), Yes},
+		{0.5, Maybe}, {1.5, Maybe}, {-2.25, Maybe},
+		{math.NaN(), Maybe},
+	}
+	for _, test := range table {
+		actual := fromNumber(test.f)
+		if actual != test.expected {
+			t.Errorf("fromNumber(%v) => %s instead of the expected %s", test.f, actual, test.expected)
+		}
+	}
+}
+
+func TestTribool_Stroke_Arrow(t *testing.T) {
+	for _, a := range values {
+		for _, b := range values {
+			if a.Stroke(b) != a.Nand(b) {
+				t.Errorf("%s.Stroke(%s) => %s instead of the expected %s", a, b, a.Stroke(b), a.Nand(b))
+			}
+			if a.Arrow(b) != a.Nor(b) {
+				t.Errorf("%s.Arrow(%s) => %s instead of the expected %s", a, b, a.Arrow(b), a.Nor(b))
+			}
+		}
+	}
+}
+
+func TestTribool_Canonicalize(t *testing.T) {
+	table := []struct {
+		tri      Tribool
+		expected Tribool
+	}{
+		{No, No}, {False, No}, {Off, No},
+		{Maybe, Maybe}, {Perhaps, Maybe}, {Indeterminate, Maybe},
+		{Yes, Yes}, {True, Yes}, {On, Yes},
+		{Tribool(-1), No}, {Tribool(-100), No},
+		{Tribool(3), Yes}, {Tribool(100), Yes},
+	}
+	for _, test := range table {
+		actual := test.tri.Canonicalize()
+		if actual != test.expected {
+			t.Errorf("Tribool(%d).Canonicalize() => %s instead of the expected %s", int(test.tri), actual, test.expected)
+		}
+		if again := actual.Canonicalize(); again != actual {
+			t.Errorf("Canonicalize is not idempotent for %d", int(test.tri))
+		}
+	}
+}
+
+func TestTribool_outOfRange(t *testing.T) {
+	table := []struct {
+		a, b    Tribool
+		and, or Tribool
+	}{
+		{Tribool(5), Yes, Yes, Yes},
+		{Tribool(5), Maybe, Maybe, Yes},
+		{Tribool(5), No, No, Yes},
+		{Tribool(5), Tribool(5), Yes, Yes},
+		{Tribool(-3), Yes, No, Yes},
+		{Tribool(-3), Maybe, No, Maybe},
+		{Tribool(-3), Tribool(5), No, Yes},
+	}
+	for _, test := range table {
+		for _, args := range [][2]Tribool{{test.a, test.b}, {test.b, test.a}} {
+			a, b := args[0], args[1]
+			if actual := a.And(b); actual != test.and {
+				t.Errorf("(%d and %d) => %s instead of the expected %s", int(a), int(b), actual, test.and)
+			}
+			if actual := a.Or(b); actual != test.or {
+				t.Errorf("(%d or %d) => %s instead of the expected %s", int(a), int(b), actual, test.or)
+			}
+		}
+	}
+
+	ops := map[string]func(a, b Tribool) Tribool{
+		"and": Tribool.And, "or": Tribool.Or, "nand": Tribool.Nand, "nor": Tribool.Nor,
+		"andNot": Tribool.AndNot, "orNot": Tribool.OrNot, "xor": Tribool.Xor,
+		"imply": Tribool.Imply, "impliedBy": Tribool.ImpliedBy, "nonImply": Tribool.NonImply,
+		"converseImply": Tribool.ConverseImply, "converseNonImply": Tribool.ConverseNonImply,
+		"equiv": Tribool.Equiv, "stroke": Tribool.Stroke, "arrow": Tribool.Arrow,
+	}
+	corrupt := []Tribool{Tribool(-3), Tribool(5), Tribool(7)}
+	for name, op := range ops {
+		for _, bad := range corrupt {
+			for _, good := range append(values[:], corrupt...) {
+				for _, args := range [][2]Tribool{{bad, good}, {good, bad}} {
+					a, b := args[0], args[1]
+					expected := op(a.Canonicalize(), b.Canonicalize())
+					if actual := op(a, b); actual != expected {
+						t.Errorf("(%d %s %d) => %s instead of the expected %s", int(a), name, int(b), actual, expected)
+					}
+				}
+			}
+		}
+	}
+
+	unary := map[string]func(a Tribool) Tribool{
+		"not": Tribool.Not, "upgrade": Tribool.Upgrade, "downgrade": Tribool.Downgrade,
+	}
+	for name, op := range unary {
+		for _, bad := range corrupt {
+			if actual, expected := op(bad), op(bad.Canonicalize()); actual != expected {
+				t.Errorf("(%s %d) => %s instead of the expected %s", name, int(bad), actual, expected)
+			}
+		}
+	}
+
+	notted := NotSlice(corrupt)
+	assertSlice(t, "NotSlice", notted, MapSlice(corrupt, Tribool.Not))
+	inPlace := append([]Tribool(nil), corrupt...)
+	NotSliceInPlace(inPlace)
+	assertSlice(t, "NotSliceInPlace", inPlace, notted)
+}
+
+func TestMin_Max(t *testing.T) {
+	for _, a := range values {
+		for _, b := range values {
+			if actual := Min(a, b); actual != a.And(b) {
+				t.Errorf("Min(%s, %s) => %s instead of the expected %s", a, b, actual, a.And(b))
+			}
+			if actual := Max(a, b); actual != a.Or(b) {
+				t.Errorf("Max(%s, %s) => %s instead of the expected %s", a, b, actual, a.Or(b))
+			}
+			if Min(a, b).Compare(a) > 0 || Max(a, b).Compare(b) < 0 {
+				t.Errorf("Min or Max of %s and %s is out of order", a, b)
+			}
+		}
+	}
+}
+
+func TestTribool_AndNot_OrNot(t *testing.T) {
+	for _, a := range values {
+		for _, b := range values {
+			if a.AndNot(b) != a.And(b.Not()) {
+				t.Errorf("%s.AndNot(%s) => %s instead of %s", a, b, a.AndNot(b), a.And(b.Not()))
+			}
+			if a.OrNot(b) != a.Or(b.Not()) {
+				t.Errorf("%s.OrNot(%s) => %s instead of %s", a, b, a.OrNot(b), a.Or(b.Not()))
+			}
+		}
+		for _, b := range []bool{false, true} {
+			if a.AndNotBool(b) != a.And(FromBool(!b)) {
+				t.Errorf("%s.AndNotBool(%v) => %s instead of %s", a, b, a.AndNotBool(b), a.And(FromBool(!b)))
+			}
+			if a.OrNotBool(b) != a.Or(FromBool(!b)) {
+				t.Errorf("%s.OrNotBool(%v) => %s instead of %s", a, b, a.OrNotBool(b), a.Or(FromBool(!b)))
+			}
+		}
+	}
+}
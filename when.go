@@ -0,0 +1,72 @@
+package tribool
+
+import "fmt"
+
+/*
+When starts a chain of callbacks, only the one matching a is called:
+
+	flag.When().
+		True(func() { ... }).
+		False(func() { ... }).
+		Maybe(func() { ... })
+*/
+func (a Tribool) When() Branch {
+	return Branch{a}
+}
+
+/*
+Branch calls the callbacks matching a Tribool. See Tribool.When.
+*/
+type Branch struct {
+	a Tribool
+}
+
+/*
+True calls f if the Tribool is Yes.
+*/
+func (br Branch) True(f func()) Branch {
+	if br.a == yes {
+		f()
+	}
+	return br
+}
+
+/*
+False calls f if the Tribool is No.
+*/
+func (br Branch) False(f func()) Branch {
+	if br.a == no {
+		f()
+	}
+	return br
+}
+
+/*
+Maybe calls f if the Tribool is Maybe.
+*/
+func (br Branch) Maybe(f func()) Branch {
+	if br.a == maybe {
+		f()
+	}
+	return br
+}
+
+/*
+MatchOrError returns onTrue, onMaybe, or onFalse, matching a. Unlike a switch
+over the three states, it returns an error rather than a silent default when a
+is not valid, so that corrupt values can be reported:
+
+	label, err := tribool.MatchOrError(flag, "on", "auto", "off")
+*/
+func MatchOrError[T any](a Tribool, onTrue, onMaybe, onFalse T) (T, error) {
+	switch a {
+	case yes:
+		return onTrue, nil
+	case maybe:
+		return onMaybe, nil
+	case no:
+		return onFalse, nil
+	}
+	var zero T
+	return zero, fmt.Errorf("tribool: invalid value %d", int(a))
+}
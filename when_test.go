@@ -0,0 +1,56 @@
+package tribool
+
+import "testing"
+
+func TestTribool_When(t *testing.T) {
+	for _, tri := range values {
+		var called []Tribool
+		tri.When().
+			True(func() { called = append(called, Yes) }).
+			False(func() { called = append(called, No) }).
+			Maybe(func() { called = append(called, Maybe) }).
+			True(func() { called = append(called, Yes) })
+
+		expected := []Tribool{tri}
+		if tri == Yes {
+			expected = append(expected, Yes)
+		}
+		assertSlice(t, "When("+tri.String()+")", called, expected)
+	}
+}
+
+func TestMatchOrError(t *testing.T) {
+	table := []struct {
+		a        Tribool
+		expected string
+	}{
+		{Yes, "on"}, {Maybe, "auto"}, {No, "off"},
+	}
+	for _, test := range table {
+		actual, err := MatchOrError(test.a, "on", "auto", "off")
+		if err != nil {
+			t.Errorf("MatchOrError(%s) returned error: %v", test.a, err)
+		} else if actual != test.expected {
+			t.Errorf("MatchOrError(%s) => %s instead of the expected %s", test.a, actual, test.expected)
+		}
+	}
+
+	for _, a := range []Tribool{Tribool(7), Tribool(-1)} {
+		if actual, err := MatchOrError(a, 1, 2, 3); err == nil || actual != 0 {
+			t.Errorf("MatchOrError(%d) => %d, %v instead of the expected 0 and an error", int(a), actual, err)
+		}
+	}
+}
+
+func TestTribool_IsValid(t *testing.T) {
+	for _, a := range values {
+		if !a.IsValid() {
+			t.Errorf("%s.IsValid() => false", a)
+		}
+	}
+	for _, a := range []Tribool{Tribool(-1), Tribool(3), Tribool(7)} {
+		if a.IsValid() {
+			t.Errorf("Tribool(%d).IsValid() => true", int(a))
+		}
+	}
+}